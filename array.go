@@ -0,0 +1,132 @@
+package critbit
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"unsafe"
+)
+
+// ByteArray is a constraint that permits fixed-size byte arrays commonly used as identifiers,
+// such as UUIDs ([16]byte), SHA-1 digests ([20]byte) and SHA-256 digests ([32]byte).
+type ByteArray interface {
+	~[16]byte | ~[20]byte | ~[32]byte
+}
+
+// MapArray implements an associative array of V indexed by byte arrays of type K.
+// Keys are ordered lexicographically by their bytes. The zero value is an empty map ready to use.
+type MapArray[K ByteArray, V any] struct {
+	tree[K, arrayKey[K], arrayCoder[K], V]
+}
+
+// NewMapArray returns a new map with keys of type K and values of type V.
+func NewMapArray[K ByteArray, V any]() *MapArray[K, V] {
+	var r MapArray[K, V]
+	return &r
+}
+
+// ArrayIterator iterates over the entries of a MapArray in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type ArrayIterator[K ByteArray, V any] struct {
+	iterator[K, arrayKey[K], arrayCoder[K], V]
+}
+
+// Iterator returns a new ArrayIterator.
+func (t *MapArray[K, V]) Iterator() *ArrayIterator[K, V] {
+	var i ArrayIterator[K, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new ArrayIterator that only visits keys in the range [lo, hi).
+func (t *MapArray[K, V]) IterRange(lo, hi K) *ArrayIterator[K, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// ArrayCursor iterates over the entries of a MapArray like an ArrayIterator, but stays valid if the map is modified.
+type ArrayCursor[K ByteArray, V any] struct {
+	iterator[K, arrayKey[K], arrayCoder[K], V]
+}
+
+// Cursor returns a new ArrayCursor.
+func (t *MapArray[K, V]) Cursor() *ArrayCursor[K, V] {
+	var c ArrayCursor[K, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapArray[K, V]) Snapshot() *MapArray[K, V] {
+	return &MapArray[K, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapArray[K, V]) With(key K, val V) *MapArray[K, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapArray[K, V]) Without(key K) *MapArray[K, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapArray is a MapArray that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapArray[K ByteArray, V any] struct {
+	atomicMap[MapArray[K, V], *MapArray[K, V], K, V]
+}
+
+// MapArrayTxn is a transaction of an AtomicMapArray.
+type MapArrayTxn[K ByteArray, V any] struct {
+	txn[MapArray[K, V], *MapArray[K, V], K, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapArray[K, V]) Begin() *MapArrayTxn[K, V] {
+	return &MapArrayTxn[K, V]{a.begin()}
+}
+
+// arrayKey is the internal representation of byte array keys.
+type arrayKey[K ByteArray] struct {
+	a K
+}
+
+func (k *arrayKey[K]) bytes() []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&k.a)), len(k.a))
+}
+
+// Compare the keys one 64 bit word at a time. All supported array sizes are multiples of 4 bytes.
+func (k arrayKey[K]) crit(o arrayKey[K]) uint {
+	var a, b = k.bytes(), o.bytes()
+	var i = 0
+	for ; i+8 <= len(a); i += 8 {
+		if x := binary.BigEndian.Uint64(a[i:]) ^ binary.BigEndian.Uint64(b[i:]); x != 0 {
+			return uint(i*8 + bits.LeadingZeros64(x))
+		}
+	}
+	if i < len(a) {
+		if x := binary.BigEndian.Uint32(a[i:]) ^ binary.BigEndian.Uint32(b[i:]); x != 0 {
+			return uint(i*8 + bits.LeadingZeros32(x))
+		}
+	}
+	return ^uint(0)
+}
+
+func (k arrayKey[K]) bit(pos uint) int {
+	return int(k.a[pos/8]>>(7-pos%8)) & 1
+}
+
+type arrayCoder[K ByteArray] struct{}
+
+func (arrayCoder[K]) encode(key K) arrayKey[K] {
+	return arrayKey[K]{key}
+}
+
+func (arrayCoder[K]) decode(key arrayKey[K]) K {
+	return key.a
+}
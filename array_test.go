@@ -0,0 +1,53 @@
+package critbit
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestArray(t *testing.T) {
+	var m20 MapArray[[20]byte, int]
+	var m32 MapArray[[32]byte, int]
+	var ref20 [][20]byte
+	var ref32 [][32]byte
+	for i := 0; i < 1000; i++ {
+		var s = []byte(strconv.Itoa(i))
+		ref20 = append(ref20, sha1.Sum(s))
+		ref32 = append(ref32, sha256.Sum256(s))
+		m20.Set(ref20[i], i)
+		m32.Set(ref32[i], i)
+	}
+	// Keys differing only in the last word
+	var a, b [20]byte
+	b[19] = 1
+	ref20 = append(ref20, a, b)
+	m20.Set(a, -1)
+	m20.Set(b, -2)
+
+	sort.Slice(ref20, func(i, j int) bool { return bytes.Compare(ref20[i][:], ref20[j][:]) < 0 })
+	sort.Slice(ref32, func(i, j int) bool { return bytes.Compare(ref32[i][:], ref32[j][:]) < 0 })
+	for i := m20.Iterator(); i.Next(); ref20 = ref20[1:] {
+		if i.Key != ref20[0] {
+			t.Fatal("Wrong key", ref20[0], i.Key)
+		}
+	}
+	for i := m32.Iterator(); i.Next(); ref32 = ref32[1:] {
+		if i.Key != ref32[0] {
+			t.Fatal("Wrong key", ref32[0], i.Key)
+		}
+	}
+	if len(ref20) > 0 || len(ref32) > 0 {
+		t.Fatal(len(ref20)+len(ref32), "elements left")
+	}
+
+	var it = m20.Iterator()
+	b[19] = 2
+	it.Seek(b)
+	if !it.Prev() || *it.Value != -2 {
+		t.Fatal("Wrong value from Prev after Seek", it.Value)
+	}
+}
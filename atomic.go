@@ -0,0 +1,124 @@
+package critbit
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// mapPointer is implemented by pointers to all map types with keys of type K and values of type V.
+type mapPointer[M any, K any, V any] interface {
+	*M
+	Get(key K) (V, bool)
+	Set(key K, val V)
+	Rem(key K)
+	share()
+	freeze()
+}
+
+// atomicMap implements the maps with lock-free readers shared by all map types. M is the underlying map type.
+// Writers copy the nodes on the path to the modified key and atomically replace the map by the new version,
+// so readers always see a consistent snapshot.
+type atomicMap[M any, P mapPointer[M, K, V], K any, V any] struct {
+	mutex   sync.Mutex // Serializes writers
+	current atomic.Pointer[M]
+}
+
+// Load returns the current version of the map. The returned map can be read and iterated over without locks
+// while other goroutines modify the AtomicMap, but it must not be modified itself, which includes calling GetP,
+// GetZeroP or GetOrInsertP. It does not reflect later writes. Any number of goroutines can derive modifiable
+// versions from it concurrently with Snapshot, With and Without.
+func (a *atomicMap[M, P, K, V]) Load() P {
+	if m := a.current.Load(); m != nil {
+		return m
+	}
+	return new(M)
+}
+
+// Store replaces the map with m. m must not be used for modifications afterwards.
+func (a *atomicMap[M, P, K, V]) Store(m P) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	m.freeze()
+	a.current.Store(m)
+}
+
+// Modify calls f with a copy of the current version of the map and then replaces the map with the copy,
+// so that all modifications made by f become visible to readers at once. If f panics, the map is not replaced.
+// The copy shares all unmodified nodes with previous versions. Modifying it only copies the nodes on the
+// path to the modified key (or to the value pointer returned by a method), and f must not retain the map.
+// Modify, Set, Rem, Store and transactions wait for each other.
+func (a *atomicMap[M, P, K, V]) Modify(f func(m P)) {
+	var t = a.begin()
+	defer t.Rollback()
+	f(t.Map())
+	t.Commit()
+}
+
+// Start a transaction. It waits until other transactions and writers have finished and blocks
+// new ones until the transaction is committed or rolled back.
+func (a *atomicMap[M, P, K, V]) begin() txn[M, P, K, V] {
+	a.mutex.Lock()
+	var next = *a.Load()
+	P(&next).share()
+	return txn[M, P, K, V]{a: a, next: &next}
+}
+
+// Set inserts or replaces the value associated with the specified key.
+func (a *atomicMap[M, P, K, V]) Set(key K, val V) {
+	a.Modify(func(m P) { m.Set(key, val) })
+}
+
+// Rem removes the value associated with the specified key from the map.
+func (a *atomicMap[M, P, K, V]) Rem(key K) {
+	a.Modify(func(m P) { m.Rem(key) })
+}
+
+// txn implements the transactions shared by all atomic map types. A transaction stages modifications of an
+// atomic map, which become visible to readers at once when the transaction is committed. Reads through the
+// transaction include its own modifications. A transaction must end with Commit or Rollback. Deferring Rollback
+// after Begin discards the modifications if a panic occurs before Commit.
+type txn[M any, P mapPointer[M, K, V], K any, V any] struct {
+	a    *atomicMap[M, P, K, V]
+	next P // Modified version of the map (nil after the transaction ended)
+}
+
+// Map returns the version of the map modified by the transaction. It can be used like the copy passed to
+// the function of Modify, but it must not be used after the transaction ended.
+func (t *txn[M, P, K, V]) Map() P {
+	return t.next
+}
+
+// Get returns the value associated with the specified key and true if the key exists.
+// Otherwise the zero value and false are returned.
+func (t *txn[M, P, K, V]) Get(key K) (V, bool) {
+	return t.next.Get(key)
+}
+
+// Set inserts or replaces the value associated with the specified key.
+func (t *txn[M, P, K, V]) Set(key K, val V) {
+	t.next.Set(key, val)
+}
+
+// Rem removes the value associated with the specified key from the map.
+func (t *txn[M, P, K, V]) Rem(key K) {
+	t.next.Rem(key)
+}
+
+// Commit replaces the map with the version modified by the transaction and ends the transaction.
+// It panics if the transaction already ended.
+func (t *txn[M, P, K, V]) Commit() {
+	if t.next == nil {
+		panic("critbit: transaction already ended")
+	}
+	t.next.freeze()
+	t.a.current.Store(t.next)
+	t.Rollback()
+}
+
+// Rollback discards the modifications of the transaction and ends it. It does nothing if the transaction already ended.
+func (t *txn[M, P, K, V]) Rollback() {
+	if t.next != nil {
+		t.next = nil
+		t.a.mutex.Unlock()
+	}
+}
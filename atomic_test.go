@@ -0,0 +1,224 @@
+package critbit
+
+import (
+	"sync"
+	"testing"
+)
+
+// Return the entries of a map as a slice of keys and a slice of values.
+func atomicEntries(m *Map[int, int]) ([]int, []int) {
+	var keys, vals []int
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		vals = append(vals, v)
+	}
+	return keys, vals
+}
+
+func TestAtomicMap(t *testing.T) {
+	var a AtomicMap[int, int]
+	if a.Load().Length() != 0 {
+		t.Fatal("Zero value not empty")
+	}
+	for i := 0; i < 100; i++ {
+		a.Set(i, i)
+	}
+	var old = a.Load()
+	var it = old.Iterator()
+	it.Next()
+	// Modifications must not be visible in the old version.
+	a.Modify(func(m *Map[int, int]) {
+		m.Rem(0)
+		m.Set(100, 100)
+		m.Update(1, func(v int, _ bool) (int, bool) { return v + 1000, true })
+		m.PopMax()
+		m.RemIf(func(k, _ int) bool { return k%2 == 0 })
+		*m.GetZeroP(-1) = -1
+	})
+	var keys, vals = atomicEntries(old)
+	if len(keys) != 100 || keys[0] != 0 || keys[99] != 99 || vals[1] != 1 {
+		t.Fatal("Old version modified", keys, vals)
+	}
+	if !it.Next() || it.Key != 1 {
+		t.Fatal("Iterator of old version invalidated", it.Key)
+	}
+	var m = a.Load()
+	keys, vals = atomicEntries(m)
+	if len(keys) != 51 || keys[0] != -1 || vals[1] != 1001 || keys[50] != 99 {
+		t.Fatal("Wrong new version", keys, vals)
+	}
+	if m.Rank(99) != 50 || old.Rank(99) != 99 {
+		t.Fatal("Wrong leaf counts", m.Rank(99), old.Rank(99))
+	}
+	// A panicking Modify doesn't replace the map.
+	func() {
+		defer func() { recover() }()
+		a.Modify(func(m *Map[int, int]) {
+			m.Rem(1)
+			panic("abort")
+		})
+	}()
+	if a.Load() != m {
+		t.Fatal("Map replaced by failed Modify")
+	}
+}
+
+func TestAtomicMapConcurrent(t *testing.T) {
+	var a AtomicMap[uint64, uint64]
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				// Each version must contain a consecutive range of keys.
+				var m, n = a.Load(), uint64(0)
+				for k := range m.Keys() {
+					if k != n {
+						t.Error("Inconsistent snapshot", k, n)
+						return
+					}
+					n++
+				}
+				if n != uint64(m.Length()) {
+					t.Error("Wrong length", n, m.Length())
+					return
+				}
+			}
+		}()
+	}
+	for i := uint64(0); i < 1000; i++ {
+		a.Set(i, i)
+	}
+	wg.Wait()
+}
+
+func TestAtomicMapConcurrentSnapshots(t *testing.T) {
+	var a AtomicMap[int, int]
+	a.Set(0, 0)
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 1; i < 100; i++ {
+				// Derived versions contain the loaded version and their own modification.
+				var m = a.Load().With(-i, i)
+				if v, ok := m.Get(-i); !ok || v != i || m.Length() < 2 {
+					t.Error("Wrong derived version", v, ok, m.Length())
+					return
+				}
+				a.Load().Snapshot().Rem(0)
+			}
+		}()
+	}
+	for i := 1; i < 100; i++ {
+		a.Set(i, i)
+	}
+	wg.Wait()
+	if v, ok := a.Load().Get(0); !ok || v != 0 || a.Load().Length() != 100 {
+		t.Fatal("Published version modified", v, ok, a.Load().Length())
+	}
+}
+
+func TestAtomicMapValuePointers(t *testing.T) {
+	var a AtomicMap[int, int]
+	a.Set(1, 10)
+	a.Set(2, 20)
+	var old = a.Load()
+	var done = make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			if v, _ := old.Get(1); v != 10 {
+				t.Error("Old version modified", v)
+				return
+			}
+		}
+	}()
+	// Writes through pointers to values of existing keys must not be visible in the old version.
+	a.Modify(func(m *Map[int, int]) {
+		*m.GetZeroP(1)++
+		*m.GetOrInsertP(2, nil) += 2
+		*m.GetP(2) += 2
+	})
+	<-done
+	if v1, _ := old.Get(1); v1 != 10 {
+		t.Fatal("Old version modified", v1)
+	}
+	if v1, _ := a.Load().Get(1); v1 != 11 {
+		t.Fatal("Wrong new value", v1)
+	}
+	if v2, _ := a.Load().Get(2); v2 != 24 {
+		t.Fatal("Wrong new value", v2)
+	}
+	// Loaded versions can't be modified, not even if they are empty.
+	var empty AtomicMap[int, int]
+	empty.Store(NewMap[int, int]())
+	for i, f := range []func(){
+		func() { old.GetP(1) },
+		func() { old.Rem(2) },
+		func() { old.Set(3, 30) },
+		func() { empty.Load().Set(1, 1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("Loaded version modified", i)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestAtomicMapCodec(t *testing.T) {
+	var a = NewAtomicMapCodec[version, int](versionCodec{})
+	a.Set(version{1, 0}, 2)
+	a.Set(version{0, 10}, 1)
+	if k, _, _ := a.Load().Min(); k != (version{0, 10}) {
+		t.Fatal("Wrong minimum", k)
+	}
+}
+
+func TestTxn(t *testing.T) {
+	// Keep an index and a reverse index in the same map, with reverse entries at negative keys.
+	var a AtomicMap[int, int]
+	var link = func(txn *MapTxn[int, int], k, v int) {
+		txn.Set(k, v)
+		txn.Set(-v, k)
+	}
+	var txn = a.Begin()
+	link(txn, 1, 10)
+	link(txn, 2, 20)
+	if v, ok := txn.Get(-20); !ok || v != 2 {
+		t.Fatal("Own write not visible", v, ok)
+	}
+	if a.Load().Length() != 0 {
+		t.Fatal("Uncommitted writes visible")
+	}
+	txn.Commit()
+	txn.Rollback()
+	if a.Load().Length() != 4 {
+		t.Fatal("Committed writes not visible", a.Load().Length())
+	}
+	// A panic halfway through leaves the map unchanged.
+	func() {
+		defer func() { recover() }()
+		var txn = a.Begin()
+		defer txn.Rollback()
+		txn.Rem(1)
+		panic("abort")
+	}()
+	txn = a.Begin()
+	if v, ok := txn.Get(1); !ok || v != 10 || txn.Map().Length() != 4 {
+		t.Fatal("Rolled back write visible", v, ok)
+	}
+	txn.Rollback()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Commit of ended transaction did not panic")
+		}
+	}()
+	txn.Commit()
+}
@@ -0,0 +1,113 @@
+package critbit
+
+// KeyCodec converts keys of type K into byte strings and back. The byte strings must be ordered
+// lexicographically like the keys they represent and distinct keys must have distinct encodings.
+type KeyCodec[K any] interface {
+	// Encode appends the encoding of key to dst and returns the extended slice.
+	Encode(dst []byte, key K) []byte
+	// Decode returns the key represented by the encoding src.
+	Decode(src []byte) K
+}
+
+// MapCodec implements an associative array of V indexed by keys of type K, which are ordered by their encodings.
+// Maps must be created with NewMapCodec.
+type MapCodec[K any, V any] struct {
+	tree[K, strKey, keyCodecCoder[K], V]
+}
+
+// NewMapCodec returns a new map with keys of type K that are encoded with the specified codec and values of type V.
+func NewMapCodec[K any, V any](codec KeyCodec[K]) *MapCodec[K, V] {
+	var r MapCodec[K, V]
+	r.codec.KeyCodec = codec
+	return &r
+}
+
+// CodecIterator iterates over the entries of a MapCodec in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type CodecIterator[K any, V any] struct {
+	iterator[K, strKey, keyCodecCoder[K], V]
+}
+
+// Iterator returns a new CodecIterator.
+func (t *MapCodec[K, V]) Iterator() *CodecIterator[K, V] {
+	var i CodecIterator[K, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new CodecIterator that only visits keys in the range [lo, hi).
+func (t *MapCodec[K, V]) IterRange(lo, hi K) *CodecIterator[K, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// CodecCursor iterates over the entries of a MapCodec like a CodecIterator, but stays valid if the map is modified.
+type CodecCursor[K any, V any] struct {
+	iterator[K, strKey, keyCodecCoder[K], V]
+}
+
+// Cursor returns a new CodecCursor.
+func (t *MapCodec[K, V]) Cursor() *CodecCursor[K, V] {
+	var c CodecCursor[K, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapCodec[K, V]) Snapshot() *MapCodec[K, V] {
+	return &MapCodec[K, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapCodec[K, V]) With(key K, val V) *MapCodec[K, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapCodec[K, V]) Without(key K) *MapCodec[K, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapCodec is a MapCodec that can be read without locks while it is modified. It must be created with NewAtomicMapCodec.
+type AtomicMapCodec[K any, V any] struct {
+	atomicMap[MapCodec[K, V], *MapCodec[K, V], K, V]
+}
+
+// MapCodecTxn is a transaction of an AtomicMapCodec.
+type MapCodecTxn[K any, V any] struct {
+	txn[MapCodec[K, V], *MapCodec[K, V], K, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapCodec[K, V]) Begin() *MapCodecTxn[K, V] {
+	return &MapCodecTxn[K, V]{a.begin()}
+}
+
+// NewAtomicMapCodec returns a new AtomicMapCodec with keys of type K that are encoded with the specified codec
+// and values of type V.
+func NewAtomicMapCodec[K any, V any](codec KeyCodec[K]) *AtomicMapCodec[K, V] {
+	var r AtomicMapCodec[K, V]
+	var m = NewMapCodec[K, V](codec)
+	m.freeze()
+	r.current.Store(m)
+	return &r
+}
+
+// keyCodecCoder adapts a KeyCodec to the internal key representation.
+type keyCodecCoder[K any] struct {
+	KeyCodec[K]
+}
+
+func (c keyCodecCoder[K]) encode(key K) strKey {
+	return strKey(c.Encode(nil, key))
+}
+
+func (c keyCodecCoder[K]) decode(key strKey) K {
+	return c.Decode([]byte(key))
+}
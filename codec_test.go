@@ -0,0 +1,54 @@
+package critbit
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// version is ordered by major, then minor version.
+type version struct{ major, minor uint16 }
+
+type versionCodec struct{}
+
+func (versionCodec) Encode(dst []byte, v version) []byte {
+	return binary.BigEndian.AppendUint16(binary.BigEndian.AppendUint16(dst, v.major), v.minor)
+}
+
+func (versionCodec) Decode(src []byte) version {
+	return version{binary.BigEndian.Uint16(src), binary.BigEndian.Uint16(src[2:])}
+}
+
+// foldCodec orders strings case-insensitively.
+type foldCodec struct{}
+
+func (foldCodec) Encode(dst []byte, s string) []byte {
+	return append(dst, strings.ToLower(s)...)
+}
+
+func (foldCodec) Decode(src []byte) string {
+	return string(src)
+}
+
+func TestCodec(t *testing.T) {
+	var m = NewMapCodec[version, string](versionCodec{})
+	var ref = []version{{0, 9}, {0, 10}, {1, 0}, {1, 2}, {10, 1}}
+	for _, v := range []int{3, 0, 4, 1, 2} {
+		m.Set(ref[v], "")
+	}
+	for i := m.Iterator(); i.Next(); ref = ref[1:] {
+		if i.Key != ref[0] {
+			t.Fatal("Wrong key", ref[0], i.Key)
+		}
+	}
+	if len(ref) > 0 {
+		t.Fatal(len(ref), "elements left")
+	}
+
+	var f = NewMapCodec[string, int](foldCodec{})
+	f.Set("Go", 1)
+	f.Set("GO", 2)
+	if v, ok := f.Get("go"); !ok || v != 2 || f.Length() != 1 {
+		t.Fatal("Keys with equal encodings are not equal", v, ok, f.Length())
+	}
+}
@@ -0,0 +1,7 @@
+// Package compat provides the named map types of earlier versions of critbit, such as MapIntInt and NewMapIntInt,
+// as aliases of critbit.Map and critbit.Iterator for all combinations of integer key types and builtin value types.
+// It is a separate package because compiling the more than 200 instantiations takes many times longer than compiling
+// critbit itself, so only programs that still use the old names should import it.
+package compat
+
+//go:generate go run gen_aliases.go
@@ -0,0 +1,18 @@
+package compat
+
+import (
+	"testing"
+
+	"github.com/infobaleen/critbit"
+)
+
+func TestAliases(t *testing.T) {
+	var m = NewMapIntInt()
+	m.Set(1, 2)
+	// The old names are interchangeable with the generic types.
+	var g *critbit.Map[int, int] = m
+	var it *IterIntInt = g.Iterator()
+	if !it.Next() || it.Key != 1 || *it.Value != 2 {
+		t.Fatal("Wrong entry", it.Key)
+	}
+}
@@ -0,0 +1,56 @@
+//go:build ignore
+
+// This program generates integerMaps.go, which contains named aliases of critbit.Map and critbit.Iterator for all
+// combinations of integer key types and builtin value types. It is invoked by go generate.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+var keyTypes = []string{"int", "int64", "int32", "int16", "int8", "uint", "uintptr", "uint64", "uint32", "uint16", "uint8"}
+
+var valueTypes = []string{"bool", "byte", "complex128", "complex64", "error", "float32", "float64", "int", "int16", "int32", "int64", "int8", "rune", "string", "uint", "uint16", "uint32", "uint64", "uint8", "uintptr"}
+
+var tmpl = template.Must(template.New("").Funcs(template.FuncMap{"title": title}).Parse(`// Code generated by gen_aliases.go; DO NOT EDIT.
+
+package compat
+
+import "github.com/infobaleen/critbit"
+{{range $k := .Keys}}{{range $v := $.Values}}{{$n := printf "%s%s" (title $k) (title $v)}}
+// Map{{$n}} implements an associative array of {{$v}} indexed by {{$k}}.
+type Map{{$n}} = critbit.Map[{{$k}}, {{$v}}]
+
+// Iter{{$n}} iterates over a Map{{$n}}.
+type Iter{{$n}} = critbit.Iterator[{{$k}}, {{$v}}]
+
+// NewMap{{$n}} returns a new map with keys of type {{$k}} and values of type {{$v}}
+func NewMap{{$n}}() *Map{{$n}} {
+	return critbit.NewMap[{{$k}}, {{$v}}]()
+}
+{{end}}{{end}}`))
+
+func title(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func main() {
+	var buffer bytes.Buffer
+	var err = tmpl.Execute(&buffer, struct{ Keys, Values []string }{keyTypes, valueTypes})
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile("integerMaps.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,177 @@
+package critbit
+
+import (
+	"sync"
+)
+
+// OrderedMap is implemented by all maps of this package with keys of type K and values of type V.
+type OrderedMap[K any, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, val V)
+	Update(key K, f func(old V, exists bool) (new V, keep bool))
+	Take(key K) (V, bool)
+	RemIf(pred func(K, V) bool) int
+	Length() int
+	peekHigher(key K, first bool) (K, V, bool)
+}
+
+// orderedMapPointer is implemented by pointers to maps of type M with keys of type K and values of type V.
+type orderedMapPointer[M any, K any, V any] interface {
+	*M
+	OrderedMap[K, V]
+}
+
+// concurrentMap implements the methods of sync.Map shared by ConcurrentMap and ConcurrentOrderedMap.
+// M is the underlying map type.
+type concurrentMap[M any, P orderedMapPointer[M, K, V], K any, V any] struct {
+	mutex sync.RWMutex
+	m     M
+}
+
+// ConcurrentMap implements an associative array of V indexed by integers of type K that is safe for concurrent use
+// by multiple goroutines. Its methods correspond to those of sync.Map, but Range visits the keys in ascending order.
+// The zero value is an empty map ready to use.
+type ConcurrentMap[K Integer, V any] struct {
+	concurrentMap[Map[K, V], *Map[K, V], K, V]
+}
+
+// ConcurrentOrderedMap wraps an OrderedMap and makes it safe for concurrent use like a ConcurrentMap.
+// Maps must be created with NewConcurrentOrderedMap.
+type ConcurrentOrderedMap[K any, V any] struct {
+	concurrentMap[orderedMap[K, V], *orderedMap[K, V], K, V]
+}
+
+// orderedMap adapts an OrderedMap to the map types used by concurrentMap.
+type orderedMap[K any, V any] struct {
+	m OrderedMap[K, V]
+}
+
+func (o *orderedMap[K, V]) Get(key K) (V, bool)                     { return o.m.Get(key) }
+func (o *orderedMap[K, V]) Set(key K, val V)                        { o.m.Set(key, val) }
+func (o *orderedMap[K, V]) Take(key K) (V, bool)                    { return o.m.Take(key) }
+func (o *orderedMap[K, V]) RemIf(pred func(K, V) bool) int          { return o.m.RemIf(pred) }
+func (o *orderedMap[K, V]) Length() int                             { return o.m.Length() }
+func (o *orderedMap[K, V]) Update(key K, f func(V, bool) (V, bool)) { o.m.Update(key, f) }
+func (o *orderedMap[K, V]) peekHigher(key K, first bool) (K, V, bool) {
+	return o.m.peekHigher(key, first)
+}
+
+// NewConcurrentOrderedMap returns a new ConcurrentOrderedMap that wraps the specified map.
+// The wrapped map must not be used directly afterwards.
+func NewConcurrentOrderedMap[K any, V any](m OrderedMap[K, V]) *ConcurrentOrderedMap[K, V] {
+	var r ConcurrentOrderedMap[K, V]
+	r.m.m = m
+	return &r
+}
+
+// Load returns the value stored in the map for a key and true, or the zero value and false if there is no such key.
+func (c *concurrentMap[M, P, K, V]) Load(key K) (value V, ok bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return P(&c.m).Get(key)
+}
+
+// Store sets the value for a key.
+func (c *concurrentMap[M, P, K, V]) Store(key K, value V) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	P(&c.m).Set(key, value)
+}
+
+// LoadOrStore returns the existing value for the key and true if present.
+// Otherwise, it stores and returns the given value and false.
+func (c *concurrentMap[M, P, K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	P(&c.m).Update(key, func(old V, exists bool) (V, bool) {
+		actual, loaded = old, exists
+		if !exists {
+			actual = value
+		}
+		return actual, true
+	})
+	return
+}
+
+// LoadAndDelete deletes the value for a key, returning the previous value and true if the key existed.
+func (c *concurrentMap[M, P, K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return P(&c.m).Take(key)
+}
+
+// Delete deletes the value for a key.
+func (c *concurrentMap[M, P, K, V]) Delete(key K) {
+	c.LoadAndDelete(key)
+}
+
+// Swap swaps the value for a key and returns the previous value and true if the key existed.
+func (c *concurrentMap[M, P, K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	P(&c.m).Update(key, func(old V, exists bool) (V, bool) {
+		previous, loaded = old, exists
+		return value, true
+	})
+	return
+}
+
+// CompareAndSwap swaps the old and new values for key if the value stored in the map is equal to old.
+// It panics if the values are not comparable.
+func (c *concurrentMap[M, P, K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	P(&c.m).Update(key, func(v V, exists bool) (V, bool) {
+		if exists && any(v) == any(old) {
+			swapped = true
+			return new, true
+		}
+		return v, exists
+	})
+	return
+}
+
+// CompareAndDelete deletes the entry for key if its value is equal to old.
+// It panics if the values are not comparable.
+func (c *concurrentMap[M, P, K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	P(&c.m).Update(key, func(v V, exists bool) (V, bool) {
+		deleted = exists && any(v) == any(old)
+		return v, exists && !deleted
+	})
+	return
+}
+
+// Range calls f sequentially for each key and value in ascending order of the keys. If f returns false,
+// Range stops the iteration. The map is not locked while f is called, so f may modify it. Like sync.Map.Range,
+// Range does not correspond to a consistent snapshot: each key is visited at most once, and keys that are
+// stored or deleted concurrently may or may not be visited.
+func (c *concurrentMap[M, P, K, V]) Range(f func(key K, value V) bool) {
+	var zero K
+	c.mutex.RLock()
+	var key, val, ok = P(&c.m).peekHigher(zero, true)
+	for ok {
+		c.mutex.RUnlock()
+		if !f(key, val) {
+			return
+		}
+		c.mutex.RLock()
+		key, val, ok = P(&c.m).peekHigher(key, false)
+	}
+	c.mutex.RUnlock()
+}
+
+// Clear deletes all entries.
+func (c *concurrentMap[M, P, K, V]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	P(&c.m).RemIf(func(K, V) bool { return true })
+}
+
+// Length returns the number of distinct keys in the map.
+func (c *concurrentMap[M, P, K, V]) Length() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return P(&c.m).Length()
+}
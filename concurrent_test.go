@@ -0,0 +1,105 @@
+package critbit
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	var m ConcurrentMap[int, int]
+	var wg sync.WaitGroup
+	// Concurrent increments with CompareAndSwap
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				var k = i % 10
+				for {
+					var v, _ = m.LoadOrStore(k, 0)
+					if m.CompareAndSwap(k, v, v+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	var last = -1
+	m.Range(func(k, v int) bool {
+		if k <= last || v != 800 {
+			t.Fatal("Wrong key or value", k, last, v)
+		}
+		last = k
+		// Modification during Range is allowed
+		m.Delete(k)
+		return true
+	})
+	if last != 9 || m.Length() != 0 {
+		t.Fatal("Range did not visit all keys", last, m.Length())
+	}
+
+	m.Store(1, 1)
+	if prev, loaded := m.Swap(1, 2); !loaded || prev != 1 {
+		t.Fatal("Wrong result from Swap", prev, loaded)
+	}
+	if m.CompareAndDelete(1, 1) || !m.CompareAndDelete(1, 2) {
+		t.Fatal("Wrong result from CompareAndDelete")
+	}
+	if v, loaded := m.LoadAndDelete(1); loaded {
+		t.Fatal("Deleted value loaded", v)
+	}
+	if v, loaded := m.LoadOrStore(2, 3); loaded || v != 3 {
+		t.Fatal("Wrong result from LoadOrStore", v, loaded)
+	}
+	m.Clear()
+	if _, ok := m.Load(2); ok {
+		t.Fatal("Value found after Clear")
+	}
+}
+
+func TestConcurrentOrderedMap(t *testing.T) {
+	var m = NewConcurrentOrderedMap[string, int](NewMapString[int]())
+	m.Store("b", 2)
+	m.Store("a", 1)
+	var keys []string
+	m.Range(func(k string, v int) bool {
+		keys = append(keys, k)
+		return true
+	})
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatal("Wrong keys", keys)
+	}
+	if v, ok := m.Load("b"); !ok || v != 2 {
+		t.Fatal("Wrong value", v, ok)
+	}
+}
+
+func TestConcurrentOrderedMapSnapshot(t *testing.T) {
+	// Range must not copy the nodes a wrapped map shares with a snapshot, because readers run concurrently
+	// (run with -race).
+	var s = NewMap[int, int]()
+	for k := 0; k < 100; k++ {
+		s.Set(k, k)
+	}
+	var m = NewConcurrentOrderedMap[int, int](s.Snapshot())
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var n = 0
+			m.Range(func(k, v int) bool {
+				if k != v {
+					t.Error("Wrong value", k, v)
+				}
+				n++
+				return true
+			})
+			if n != 100 {
+				t.Error("Wrong number of keys", n)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,121 @@
+package critbit
+
+import (
+	"math"
+)
+
+// Float is a constraint that permits any floating-point type.
+// It matches golang.org/x/exp/constraints.Float.
+type Float interface {
+	~float32 | ~float64
+}
+
+// MapFloat implements an associative array of V indexed by floating-point numbers of type K.
+// Keys are ordered numerically. Negative zero is stored as positive zero, so both refer to the same entry.
+// All NaN values refer to a single entry, which is ordered after positive infinity.
+// The zero value is an empty map ready to use.
+type MapFloat[K Float, V any] struct {
+	tree[K, uintKey, floatCoder[K], V]
+}
+
+// NewMapFloat returns a new map with keys of type K and values of type V.
+func NewMapFloat[K Float, V any]() *MapFloat[K, V] {
+	var r MapFloat[K, V]
+	return &r
+}
+
+// FloatIterator iterates over the entries of a MapFloat in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type FloatIterator[K Float, V any] struct {
+	iterator[K, uintKey, floatCoder[K], V]
+}
+
+// Iterator returns a new FloatIterator.
+func (t *MapFloat[K, V]) Iterator() *FloatIterator[K, V] {
+	var i FloatIterator[K, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new FloatIterator that only visits keys in the range [lo, hi).
+func (t *MapFloat[K, V]) IterRange(lo, hi K) *FloatIterator[K, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// FloatCursor iterates over the entries of a MapFloat like a FloatIterator, but stays valid if the map is modified.
+type FloatCursor[K Float, V any] struct {
+	iterator[K, uintKey, floatCoder[K], V]
+}
+
+// Cursor returns a new FloatCursor.
+func (t *MapFloat[K, V]) Cursor() *FloatCursor[K, V] {
+	var c FloatCursor[K, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapFloat[K, V]) Snapshot() *MapFloat[K, V] {
+	return &MapFloat[K, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapFloat[K, V]) With(key K, val V) *MapFloat[K, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapFloat[K, V]) Without(key K) *MapFloat[K, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapFloat is a MapFloat that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapFloat[K Float, V any] struct {
+	atomicMap[MapFloat[K, V], *MapFloat[K, V], K, V]
+}
+
+// MapFloatTxn is a transaction of an AtomicMapFloat.
+type MapFloatTxn[K Float, V any] struct {
+	txn[MapFloat[K, V], *MapFloat[K, V], K, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapFloat[K, V]) Begin() *MapFloatTxn[K, V] {
+	return &MapFloatTxn[K, V]{a.begin()}
+}
+
+// floatCoder converts floating-point keys into uintKey. The sign bit of positive numbers is set and all bits
+// of negative numbers are flipped, which orders the IEEE 754 representations like the numbers they represent.
+type floatCoder[K Float] struct{}
+
+func (floatCoder[K]) encode(key K) uintKey {
+	var f = float64(key)
+	switch {
+	case f == 0:
+		f = 0
+	case f != f:
+		f = math.NaN()
+	}
+	var b = math.Float64bits(f)
+	if b>>63 != 0 {
+		return uintKey(^b)
+	}
+	return uintKey(b | 1<<63)
+}
+
+func (floatCoder[K]) decode(key uintKey) K {
+	var b = uint64(key)
+	if b>>63 != 0 {
+		b &^= 1 << 63
+	} else {
+		b = ^b
+	}
+	return K(math.Float64frombits(b))
+}
@@ -0,0 +1,60 @@
+package critbit
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestFloat(t *testing.T) {
+	var ref = []float64{math.Inf(-1), -math.MaxFloat64, -1, -math.SmallestNonzeroFloat64, 0, math.SmallestNonzeroFloat64, 1, math.MaxFloat64, math.Inf(1)}
+	for i := 0; i < 1000; i++ {
+		ref = append(ref, rand.NormFloat64()*1e10)
+	}
+	var m MapFloat[float64, float64]
+	for _, r := range ref {
+		m.Set(r, r)
+	}
+	// Zero and NaN are normalized
+	m.Set(math.Copysign(0, -1), 0)
+	m.Set(math.NaN(), 1)
+	m.Set(-math.NaN(), 2)
+	if l := m.Length(); l != len(ref)+1 {
+		t.Fatal("Wrong length", l, len(ref)+1)
+	}
+	if v, ok := m.Get(math.NaN()); !ok || v != 2 {
+		t.Fatal("Wrong value for NaN", v, ok)
+	}
+
+	sort.Float64s(ref)
+	var i = m.Iterator()
+	for _, r := range ref {
+		if !i.Next() || i.Key != r || *i.Value != r {
+			t.Fatal("Wrong key or value", r, i.Key, i.Value)
+		}
+	}
+	if !i.Next() || !math.IsNaN(i.Key) || i.Next() {
+		t.Fatal("NaN is not the last key", i.Key)
+	}
+}
+
+func TestFloat32Seek(t *testing.T) {
+	var m MapFloat[float32, int]
+	for _, k := range []float32{-2.5, -1, 0, 0.5, 3} {
+		m.Set(k, 0)
+	}
+	var it = m.Iterator()
+	it.Seek(-1.5)
+	if !it.Next() || it.Key != -1 {
+		t.Fatal("Wrong key from Next after Seek", it.Key)
+	}
+	it.Seek(-1.5)
+	if !it.Prev() || it.Key != -2.5 {
+		t.Fatal("Wrong key from Prev after Seek", it.Key)
+	}
+	it.Seek(float32(math.Copysign(0, -1)))
+	if !it.Next() || it.Key != 0 || math.Signbit(float64(it.Key)) {
+		t.Fatal("Wrong key from Next after Seek to negative zero", it.Key)
+	}
+}
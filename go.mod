@@ -0,0 +1,3 @@
+module github.com/infobaleen/critbit
+
+go 1.23
@@ -0,0 +1,212 @@
+package critbit
+
+import (
+	"math/bits"
+)
+
+// Uint128 is an unsigned 128 bit integer.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// Int128 is a signed 128 bit integer in two's complement representation.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// MapUint128 implements an associative array of V indexed by Uint128.
+// The zero value is an empty map ready to use.
+type MapUint128[V any] struct {
+	tree[Uint128, Uint128, uint128Coder, V]
+}
+
+// NewMapUint128 returns a new map with keys of type Uint128 and values of type V.
+func NewMapUint128[V any]() *MapUint128[V] {
+	var r MapUint128[V]
+	return &r
+}
+
+// Uint128Iterator iterates over the entries of a MapUint128 in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type Uint128Iterator[V any] struct {
+	iterator[Uint128, Uint128, uint128Coder, V]
+}
+
+// Iterator returns a new Uint128Iterator.
+func (t *MapUint128[V]) Iterator() *Uint128Iterator[V] {
+	var i Uint128Iterator[V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new Uint128Iterator that only visits keys in the range [lo, hi).
+func (t *MapUint128[V]) IterRange(lo, hi Uint128) *Uint128Iterator[V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// Uint128Cursor iterates over the entries of a MapUint128 like a Uint128Iterator, but stays valid if the map is modified.
+type Uint128Cursor[V any] struct {
+	iterator[Uint128, Uint128, uint128Coder, V]
+}
+
+// Cursor returns a new Uint128Cursor.
+func (t *MapUint128[V]) Cursor() *Uint128Cursor[V] {
+	var c Uint128Cursor[V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapUint128[V]) Snapshot() *MapUint128[V] {
+	return &MapUint128[V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapUint128[V]) With(key Uint128, val V) *MapUint128[V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapUint128[V]) Without(key Uint128) *MapUint128[V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapUint128 is a MapUint128 that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapUint128[V any] struct {
+	atomicMap[MapUint128[V], *MapUint128[V], Uint128, V]
+}
+
+// MapUint128Txn is a transaction of an AtomicMapUint128.
+type MapUint128Txn[V any] struct {
+	txn[MapUint128[V], *MapUint128[V], Uint128, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapUint128[V]) Begin() *MapUint128Txn[V] {
+	return &MapUint128Txn[V]{a.begin()}
+}
+
+// MapInt128 implements an associative array of V indexed by Int128.
+// The zero value is an empty map ready to use.
+type MapInt128[V any] struct {
+	tree[Int128, Uint128, int128Coder, V]
+}
+
+// NewMapInt128 returns a new map with keys of type Int128 and values of type V.
+func NewMapInt128[V any]() *MapInt128[V] {
+	var r MapInt128[V]
+	return &r
+}
+
+// Int128Iterator iterates over the entries of a MapInt128 in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type Int128Iterator[V any] struct {
+	iterator[Int128, Uint128, int128Coder, V]
+}
+
+// Iterator returns a new Int128Iterator.
+func (t *MapInt128[V]) Iterator() *Int128Iterator[V] {
+	var i Int128Iterator[V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new Int128Iterator that only visits keys in the range [lo, hi).
+func (t *MapInt128[V]) IterRange(lo, hi Int128) *Int128Iterator[V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// Int128Cursor iterates over the entries of a MapInt128 like an Int128Iterator, but stays valid if the map is modified.
+type Int128Cursor[V any] struct {
+	iterator[Int128, Uint128, int128Coder, V]
+}
+
+// Cursor returns a new Int128Cursor.
+func (t *MapInt128[V]) Cursor() *Int128Cursor[V] {
+	var c Int128Cursor[V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapInt128[V]) Snapshot() *MapInt128[V] {
+	return &MapInt128[V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapInt128[V]) With(key Int128, val V) *MapInt128[V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapInt128[V]) Without(key Int128) *MapInt128[V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapInt128 is a MapInt128 that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapInt128[V any] struct {
+	atomicMap[MapInt128[V], *MapInt128[V], Int128, V]
+}
+
+// MapInt128Txn is a transaction of an AtomicMapInt128.
+type MapInt128Txn[V any] struct {
+	txn[MapInt128[V], *MapInt128[V], Int128, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapInt128[V]) Begin() *MapInt128Txn[V] {
+	return &MapInt128Txn[V]{a.begin()}
+}
+
+func (k Uint128) crit(o Uint128) uint {
+	if x := k.Hi ^ o.Hi; x != 0 {
+		return uint(bits.LeadingZeros64(x))
+	}
+	if x := k.Lo ^ o.Lo; x != 0 {
+		return uint(64 + bits.LeadingZeros64(x))
+	}
+	return ^uint(0)
+}
+
+func (k Uint128) bit(pos uint) int {
+	if pos < 64 {
+		return int(k.Hi>>(63-pos)) & 1
+	}
+	return int(k.Lo>>(127-pos)) & 1
+}
+
+type uint128Coder struct{}
+
+func (uint128Coder) encode(key Uint128) Uint128 {
+	return key
+}
+
+func (uint128Coder) decode(key Uint128) Uint128 {
+	return key
+}
+
+// int128Coder flips the sign bit so that negative keys are ordered before positive ones.
+type int128Coder struct{}
+
+func (int128Coder) encode(key Int128) Uint128 {
+	return Uint128{uint64(key.Hi) ^ 1<<63, key.Lo}
+}
+
+func (int128Coder) decode(key Uint128) Int128 {
+	return Int128{int64(key.Hi ^ 1<<63), key.Lo}
+}
@@ -0,0 +1,47 @@
+package critbit
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestInt128(t *testing.T) {
+	var ref []Int128
+	var m MapInt128[Int128]
+	for i := 0; i < 1000; i++ {
+		// Use few distinct high words to get keys that only differ in the low word
+		var k = Int128{int64(rand.Intn(5) - 2), rand.Uint64()}
+		if m.GetP(k) == nil {
+			ref = append(ref, k)
+		}
+		m.Set(k, k)
+	}
+	sort.Slice(ref, func(i, j int) bool {
+		return ref[i].Hi < ref[j].Hi || ref[i].Hi == ref[j].Hi && ref[i].Lo < ref[j].Lo
+	})
+	for i := m.Iterator(); i.Next(); ref = ref[1:] {
+		if i.Key != ref[0] || *i.Value != ref[0] {
+			t.Fatal("Wrong key or value", ref[0], i.Key, i.Value)
+		}
+	}
+	if len(ref) > 0 {
+		t.Fatal(len(ref), "elements left")
+	}
+}
+
+func TestUint128Seek(t *testing.T) {
+	var m MapUint128[int]
+	var keys = []Uint128{{0, 5}, {0, 1 << 63}, {1, 0}, {1 << 63, 0}}
+	for i, k := range keys {
+		m.Set(k, i)
+	}
+	var it = m.Iterator()
+	for i, k := range keys {
+		var next = Uint128{k.Hi, k.Lo + 1}
+		it.Seek(next)
+		if !it.Prev() || *it.Value != i {
+			t.Fatal("Wrong value from Prev after Seek", next, it.Value)
+		}
+	}
+}
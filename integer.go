@@ -0,0 +1,123 @@
+package critbit
+
+import (
+	"math/bits"
+)
+
+// Integer is a constraint that permits any integer type.
+// It matches golang.org/x/exp/constraints.Integer.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Map implements an associative array of V indexed by integers of type K.
+// The zero value is an empty map ready to use.
+type Map[K Integer, V any] struct {
+	tree[K, uintKey, intCoder[K], V]
+}
+
+// NewMap returns a new map with keys of type K and values of type V.
+func NewMap[K Integer, V any]() *Map[K, V] {
+	var r Map[K, V]
+	return &r
+}
+
+// Iterator iterates over the entries of a Map in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type Iterator[K Integer, V any] struct {
+	iterator[K, uintKey, intCoder[K], V]
+}
+
+// Iterator returns a new Iterator.
+func (t *Map[K, V]) Iterator() *Iterator[K, V] {
+	var i Iterator[K, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new Iterator that only visits keys in the range [lo, hi).
+func (t *Map[K, V]) IterRange(lo, hi K) *Iterator[K, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// Cursor iterates over the entries of a Map like an Iterator, but stays valid if the map is modified.
+type Cursor[K Integer, V any] struct {
+	iterator[K, uintKey, intCoder[K], V]
+}
+
+// Cursor returns a new Cursor.
+func (t *Map[K, V]) Cursor() *Cursor[K, V] {
+	var c Cursor[K, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *Map[K, V]) Snapshot() *Map[K, V] {
+	return &Map[K, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *Map[K, V]) With(key K, val V) *Map[K, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *Map[K, V]) Without(key K) *Map[K, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMap is a Map that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMap[K Integer, V any] struct {
+	atomicMap[Map[K, V], *Map[K, V], K, V]
+}
+
+// MapTxn is a transaction of an AtomicMap.
+type MapTxn[K Integer, V any] struct {
+	txn[Map[K, V], *Map[K, V], K, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMap[K, V]) Begin() *MapTxn[K, V] {
+	return &MapTxn[K, V]{a.begin()}
+}
+
+// uintKey is the internal representation of integer keys.
+type uintKey uint64
+
+func (k uintKey) crit(o uintKey) uint {
+	if k == o {
+		return ^uint(0)
+	}
+	return uint(bits.LeadingZeros64(uint64(k ^ o)))
+}
+
+func (k uintKey) bit(pos uint) int {
+	return int(k>>(63-pos)) & 1
+}
+
+// intCoder converts integer keys into uintKey. Integers are sign extended to 64 bits and the sign bit is
+// flipped so that negative keys are ordered before positive ones.
+type intCoder[K Integer] struct{}
+
+func (intCoder[K]) signMask() uintKey {
+	if ^K(0) < 0 {
+		return 1 << 63
+	}
+	return 0
+}
+
+func (c intCoder[K]) encode(key K) uintKey {
+	return uintKey(key) ^ c.signMask()
+}
+
+func (c intCoder[K]) decode(key uintKey) K {
+	return K(key ^ c.signMask())
+}
@@ -3,6 +3,7 @@ package critbit
 import (
 	"math/rand"
 	"sort"
+	"strconv"
 	"testing"
 )
 
@@ -16,8 +17,8 @@ func TestBasic(t *testing.T) {
 	const L = 10000
 	var iRef = make([]int, L)
 	var uRef = make(UintSlice, L)
-	var iMap MapIntInt
-	var uMap MapUintUint
+	var iMap Map[int, int]
+	var uMap Map[uint, uint]
 
 	// Add random entries to tree and reference array
 	for i := 0; i < L; i++ {
@@ -74,7 +75,7 @@ func TestBasic(t *testing.T) {
 }
 
 func TestSeek(t *testing.T) {
-	var m MapUintUint
+	var m Map[uint, uint]
 	var uRef = UintSlice{8, 4, 0, 5, 7, 1}
 	for _, v := range uRef {
 		m.Set(v, v)
@@ -112,9 +113,576 @@ func TestSeek(t *testing.T) {
 	}
 }
 
-func benchmarkInitMap() *MapIntInt {
+func TestIteratorBounds(t *testing.T) {
+	var m Map[int, int]
+	for k := -10; k <= 10; k += 2 {
+		m.Set(k, k)
+	}
+	var it = m.IterRange(-3, 5)
+	var want = []int{-2, 0, 2, 4}
+	for _, w := range want {
+		if !it.Next() || it.Key != w {
+			t.Fatal("Wrong key from Next", w, it.Key)
+		}
+	}
+	if it.Next() || it.Next() {
+		t.Fatal("Next left the bounds", it.Key)
+	}
+	for k := len(want) - 1; k >= 0; k-- {
+		if !it.Prev() || it.Key != want[k] {
+			t.Fatal("Wrong key from Prev", want[k], it.Key)
+		}
+	}
+	if it.Prev() {
+		t.Fatal("Prev left the bounds", it.Key)
+	}
+	for _, seek := range []int{-100, -3, -2, 0, 3, 4, 5, 100} {
+		it.Seek(seek)
+		var next, prev = it.Next(), false
+		if next {
+			if it.Key < seek && seek >= -2 || it.Key < -2 || it.Key > 4 {
+				t.Fatal("Wrong key from Next after Seek", seek, it.Key)
+			}
+		}
+		it.Seek(seek)
+		prev = it.Prev()
+		if prev && (it.Key > seek || it.Key < -2 || it.Key > 4) {
+			t.Fatal("Wrong key from Prev after Seek", seek, it.Key)
+		}
+		if !next && seek < 5 || !prev && seek > -3 {
+			t.Fatal("No key found after Seek", seek, next, prev)
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	var m Map[int16, int16]
+	if _, _, ok := m.Min(); ok {
+		t.Fatal("Min of empty map found")
+	}
+	var ref []int16
+	for i := 0; i < 200; i++ {
+		var k = int16(rand.Intn(1000) - 500)
+		if m.GetP(k) == nil {
+			ref = append(ref, k)
+		}
+		m.Set(k, k)
+	}
+	sort.Slice(ref, func(i, j int) bool { return ref[i] < ref[j] })
+	if k, v, ok := m.Min(); !ok || k != ref[0] || *v != k {
+		t.Fatal("Wrong Min", k, ref[0])
+	}
+	if k, v, ok := m.Max(); !ok || k != ref[len(ref)-1] || *v != k {
+		t.Fatal("Wrong Max", k, ref[len(ref)-1])
+	}
+	// Compare with reference for each method. Index -1 or len(ref) means that there is no such key.
+	var check = func(name string, f func(int16) (int16, *int16, bool), key int16, i int) {
+		var k, v, ok = f(key)
+		if i < 0 || i >= len(ref) {
+			if ok {
+				t.Fatal("Found non-existent key", name, key, k)
+			}
+		} else if !ok || k != ref[i] || *v != ref[i] {
+			t.Fatal("Wrong key", name, key, k, ref[i], ok)
+		}
+	}
+	for key := int16(-510); key <= 510; key++ {
+		var i = sort.Search(len(ref), func(i int) bool { return ref[i] >= key })
+		var exists = i < len(ref) && ref[i] == key
+		check("Ceiling", m.Ceiling, key, i)
+		check("Lower", m.Lower, key, i-1)
+		if exists {
+			check("Floor", m.Floor, key, i)
+			check("Higher", m.Higher, key, i+1)
+		} else {
+			check("Floor", m.Floor, key, i-1)
+			check("Higher", m.Higher, key, i)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	var m Map[int, int]
+	var ref []int
+	for i := 0; i < 2000; i++ {
+		var k = rand.Intn(4000) - 2000
+		if m.GetP(k) == nil {
+			ref = append(ref, k)
+		}
+		m.Set(k, k)
+	}
+	// Remove some entries to check maintenance of the counts
+	for _, k := range ref[len(ref)/2:] {
+		m.Rem(k)
+	}
+	ref = ref[:len(ref)/2]
+	sort.Ints(ref)
+	for i, r := range ref {
+		if k, v, ok := m.Select(i); !ok || k != r || *v != r {
+			t.Fatal("Wrong key from Select", i, k, r)
+		}
+	}
+	if _, _, ok := m.Select(len(ref)); ok {
+		t.Fatal("Select found key beyond end")
+	}
+	for k := -2010; k <= 2010; k += 7 {
+		var rank = sort.SearchInts(ref, k)
+		if r := m.Rank(k); r != rank {
+			t.Fatal("Wrong Rank", k, r, rank)
+		}
+		var hi = k + 100
+		if c := m.CountRange(k, hi); c != sort.SearchInts(ref, hi)-rank {
+			t.Fatal("Wrong CountRange", k, hi, c)
+		}
+		if c := m.CountRange(hi, k); c != 0 {
+			t.Fatal("Wrong CountRange for empty range", hi, k, c)
+		}
+	}
+}
+
+func TestSeekVariants(t *testing.T) {
+	var m Map[int, int]
+	for k := -4; k <= 4; k += 2 {
+		m.Set(k, k)
+	}
+	var it = m.Iterator()
+	for _, c := range []struct {
+		seek       func(int, ...int)
+		key        int
+		next, prev int // 99 if there is no key
+	}{
+		{it.SeekGE, 0, 0, 0}, {it.SeekGE, 1, 2, 0}, {it.SeekGT, 0, 2, 0}, {it.SeekGT, 1, 2, 0}, {it.SeekGT, 4, 99, 4},
+		{it.SeekLE, 0, 0, 0}, {it.SeekLE, -1, 0, -2}, {it.SeekLT, 0, 0, -2}, {it.SeekLT, -1, 0, -2}, {it.SeekLT, -4, -4, 99},
+	} {
+		c.seek(c.key)
+		if ok := it.Next(); ok != (c.next != 99) || ok && it.Key != c.next {
+			t.Fatal("Wrong key from Next after Seek", c.key, c.next, it.Key, ok)
+		}
+		c.seek(c.key)
+		if ok := it.Prev(); ok != (c.prev != 99) || ok && it.Key != c.prev {
+			t.Fatal("Wrong key from Prev after Seek", c.key, c.prev, it.Key, ok)
+		}
+	}
+	it.SeekGT(-4, 2)
+	if !it.Next() || it.Key != -2 || !it.Next() || it.Key != 0 || it.Next() {
+		t.Fatal("SeekGT did not stop at bound", it.Key)
+	}
+	it = m.Iterator()
+	it.SeekLE(4, -2)
+	if !it.Prev() || it.Key != 4 || !it.Prev() || it.Key != 2 || !it.Prev() || it.Key != 0 || !it.Prev() || it.Key != -2 || it.Prev() {
+		t.Fatal("SeekLE did not stop at bound", it.Key)
+	}
+}
+
+func TestModified(t *testing.T) {
+	var m Map[int, int]
+	for k := 0; k < 10; k++ {
+		m.Set(k, k)
+	}
+	var expectPanic = func(f func()) {
+		defer func() {
+			if r := recover(); r != ErrModified {
+				t.Fatal("Expected ErrModified, got", r)
+			}
+		}()
+		f()
+	}
+	var it = m.Iterator()
+	it.Next()
+	// Replacing a value does not invalidate the iterator
+	m.Set(0, 1)
+	it.Next()
+	m.Rem(5)
+	expectPanic(func() { it.Next() })
+	it.Seek(4)
+	if !it.Next() || it.Key != 4 || !it.Next() || it.Key != 6 {
+		t.Fatal("Wrong key after Seek", it.Key)
+	}
+	m.Set(5, 5)
+	expectPanic(func() { it.Prev() })
+	expectPanic(func() {
+		for k := range m.All() {
+			m.Rem(k)
+		}
+	})
+}
+
+func TestRemDuringIteration(t *testing.T) {
+	var m Map[int, int]
+	for k := 0; k < 1000; k++ {
+		m.Set(k, k)
+	}
+	// Remove every third key while iterating in both directions
+	for it := m.Iterator(); it.Next(); {
+		if it.Key%3 == 0 {
+			it.Rem()
+		}
+	}
+	for it := m.Iterator(); it.Prev(); {
+		if it.Key%3 == 1 {
+			it.Rem()
+			if it.Value != nil {
+				t.Fatal("Value not reset by Rem")
+			}
+		}
+	}
+	if l := m.Length(); l != 333 {
+		t.Fatal("Wrong length", l)
+	}
+	if n := m.RemIf(func(k, v int) bool { return k < 500 }); n != 166 {
+		t.Fatal("Wrong number of removed keys", n)
+	}
+	var k = 500
+	for it := m.Iterator(); it.Next(); k += 3 {
+		if it.Key != k {
+			t.Fatal("Wrong key", it.Key, k)
+		}
+	}
+	if _, _, ok := m.Select(m.Length() - 1); !ok || m.Rank(998) != m.Length()-1 {
+		t.Fatal("Wrong counts after RemIf")
+	}
+	if n := m.RemIf(func(k, v int) bool { return true }); n != 167 || m.Length() != 0 {
+		t.Fatal("Not all keys removed", n, m.Length())
+	}
+	// Iterator of a prefix that is removed completely
+	var s MapString[int]
+	s.Set("a", 0)
+	s.Set("b", 1)
+	var it = s.IterPrefix("a")
+	it.Next()
+	it.Rem()
+	if it.Next() || it.Prev() {
+		t.Fatal("Key found after removal of prefix", it.Key)
+	}
+}
+
+func TestJump(t *testing.T) {
+	var m Map[int, int]
+	for k := 0; k < 10; k += 2 {
+		m.Set(k, k)
+	}
+	var it = m.IterRange(2, 8)
+	if !it.First() || it.Key != 2 || !it.Last() || it.Key != 6 {
+		t.Fatal("Wrong key from First or Last", it.Key)
+	}
+	if !it.Jump(4) || it.Key != 4 || *it.Value != 4 {
+		t.Fatal("Wrong key or value from Jump", it.Key)
+	}
+	// Continue after modification
+	m.Set(5, 5)
+	m.Rem(6)
+	if !it.Jump(it.Key) || !it.Next() || it.Key != 5 || it.Next() {
+		t.Fatal("Wrong key after Jump in modified map", it.Key)
+	}
+	if it.Jump(3) || it.Value != nil || !it.Next() || it.Key != 4 {
+		t.Fatal("Wrong key after Jump to non-existent key", it.Key)
+	}
+	if it.Jump(8) || !it.Prev() || it.Key != 5 {
+		t.Fatal("Wrong key after Jump out of bounds", it.Key)
+	}
+	if it.Jump(0) || it.Prev() {
+		t.Fatal("Key below bounds found", it.Key)
+	}
+	if it.Jump(0) || !it.Next() || it.Key != 2 {
+		t.Fatal("Wrong key after Jump out of bounds", it.Key)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	var m Map[int, int]
+	for k := 0; k < 1000; k += 2 {
+		m.Set(k, k)
+	}
+	// Scan while keys are inserted and removed. Even keys below 900 are never removed and must all be found.
+	var c = m.Cursor()
+	var last, found = -1, 0
+	for c.Next() {
+		if c.Key <= last {
+			t.Fatal("Key not higher than last key", c.Key, last)
+		}
+		last = c.Key
+		if c.Key%2 == 0 && c.Key < 900 {
+			found++
+		}
+		m.Set(rand.Intn(1000)|1, 0)
+		m.Rem(rand.Intn(1000) | 1)
+		m.Rem(900 + rand.Intn(50)*2)
+	}
+	if found != 450 {
+		t.Fatal("Wrong number of stable keys found", found)
+	}
+	// Keys inserted after the end was reached are found
+	m.Set(2000, 0)
+	if !c.Next() || c.Key != 2000 {
+		t.Fatal("Key inserted after end not found", c.Key)
+	}
+	// Removal of the current key
+	c.Seek(10)
+	c.Next()
+	m.Rem(10)
+	c.Rem()
+	if !c.Prev() || c.Key >= 10 || !c.Next() || c.Key <= 10 {
+		t.Fatal("Wrong key after removal", c.Key)
+	}
+	// Seek state survives modification
+	c.Seek(20)
+	m.Rem(20)
+	m.Set(21, 0)
+	if !c.Next() || c.Key != 21 {
+		t.Fatal("Wrong key after Seek and modification", c.Key)
+	}
+}
+
+func TestCursorRemEnds(t *testing.T) {
+	var m Map[int, int]
+	for k := 1; k <= 3; k++ {
+		m.Set(k, k)
+	}
+	// Removing the highest key must not be mistaken for the end of the map
+	var c = m.Cursor()
+	c.Next()
+	c.Next()
+	c.Next()
+	c.Rem()
+	m.Set(5, 5)
+	if !c.Prev() || c.Key != 2 {
+		t.Fatal("Wrong key from Prev after removing the highest key", c.Key)
+	}
+	// Same for the lowest key
+	c = m.Cursor()
+	c.Next()
+	c.Rem()
+	m.Set(0, 0)
+	if !c.Next() || c.Key != 2 {
+		t.Fatal("Wrong key from Next after removing the lowest key", c.Key)
+	}
+	// Keys inserted beyond the removed key are found in the same direction
+	c = m.Cursor()
+	c.Last()
+	c.Rem()
+	m.Set(7, 7)
+	if !c.Next() || c.Key != 7 {
+		t.Fatal("Wrong key from Next after removing the highest key", c.Key)
+	}
+}
+
+func TestPop(t *testing.T) {
+	var m Map[int, string]
+	if _, _, ok := m.PopMin(); ok {
+		t.Fatal("PopMin of empty map succeeded")
+	}
+	var ref []int
+	for i := 0; i < 100; i++ {
+		var k = rand.Intn(1000)
+		if m.GetP(k) == nil {
+			ref = append(ref, k)
+		}
+		m.Set(k, strconv.Itoa(k))
+	}
+	sort.Ints(ref)
+	if v, ok := m.Take(ref[10]); !ok || v != strconv.Itoa(ref[10]) {
+		t.Fatal("Wrong value from Take", v, ok)
+	}
+	if _, ok := m.Take(ref[10]); ok {
+		t.Fatal("Take of removed key succeeded")
+	}
+	ref = append(ref[:10], ref[11:]...)
+	for len(ref) > 0 {
+		var k, v, ok = m.PopMin()
+		if !ok || k != ref[0] || v != strconv.Itoa(k) {
+			t.Fatal("Wrong entry from PopMin", k, v, ref[0])
+		}
+		ref = ref[1:]
+		if len(ref) == 0 {
+			break
+		}
+		k, v, ok = m.PopMax()
+		if !ok || k != ref[len(ref)-1] || v != strconv.Itoa(k) {
+			t.Fatal("Wrong entry from PopMax", k, v, ref[len(ref)-1])
+		}
+		ref = ref[:len(ref)-1]
+		if m.Length() != len(ref) || len(ref) > 0 && m.Rank(ref[len(ref)-1]) != len(ref)-1 {
+			t.Fatal("Wrong length or rank after pop", m.Length(), len(ref))
+		}
+	}
+	if m.Length() != 0 {
+		t.Fatal("Map not empty", m.Length())
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var m MapString[int]
+	var words = []string{"a", "b", "a", "c", "a", "b"}
+	for _, w := range words {
+		m.Update(w, func(old int, exists bool) (int, bool) {
+			return old + 1, true
+		})
+	}
+	if a, _ := m.Get("a"); a != 3 || m.Length() != 3 {
+		t.Fatal("Wrong count", a, m.Length())
+	}
+	// Decrement and remove at zero
+	for _, w := range words {
+		m.Update(w, func(old int, exists bool) (int, bool) {
+			if !exists {
+				t.Fatal("Key does not exist", w)
+			}
+			return old - 1, old > 1
+		})
+	}
+	if m.Length() != 0 {
+		t.Fatal("Keys not removed", m.Length())
+	}
+	m.Update("x", func(old int, exists bool) (int, bool) { return 0, false })
+	if m.Length() != 0 {
+		t.Fatal("Key inserted without keep")
+	}
+	var p = m.GetOrInsertP("y", func() int { return 5 })
+	if q := m.GetOrInsertP("y", func() int { t.Fatal("f called for existing key"); return 0 }); p != q || *q != 5 {
+		t.Fatal("Wrong value from GetOrInsertP", *q)
+	}
+	if p := m.GetZeroP("z"); *p != 0 || m.Length() != 2 || m.Rank("z") != 1 {
+		t.Fatal("Wrong value from GetZeroP", *p)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	// Derive versions from random earlier versions and compare all of them with reference maps at the end.
+	var versions = []*Map[int, int]{NewMap[int, int]()}
+	var refs = []map[int]int{{}}
+	for i := 0; i < 1000; i++ {
+		var j, k = rand.Intn(len(versions)), rand.Intn(100)
+		var m, ref = versions[j], make(map[int]int)
+		for k, v := range refs[j] {
+			ref[k] = v
+		}
+		switch rand.Intn(5) {
+		case 0:
+			m = m.With(k, i)
+			ref[k] = i
+		case 1:
+			m = m.Without(k)
+			delete(ref, k)
+		case 2:
+			m = m.Snapshot()
+			m.Update(k, func(v int, _ bool) (int, bool) { return v + 1, true })
+			ref[k]++
+		case 3:
+			m = m.Snapshot()
+			*m.GetZeroP(k) += i
+			ref[k] += i
+		default:
+			m = m.Snapshot()
+			m.PopMin()
+			m.RemIf(func(key, _ int) bool { return key == k })
+			var lowest = -1
+			for key := range ref {
+				if lowest < 0 || key < lowest {
+					lowest = key
+				}
+			}
+			delete(ref, lowest)
+			delete(ref, k)
+		}
+		versions = append(versions, m)
+		refs = append(refs, ref)
+	}
+	for i, m := range versions {
+		if m.Length() != len(refs[i]) {
+			t.Fatal("Wrong length", i, m.Length(), len(refs[i]))
+		}
+		var rank = 0
+		for k, v := range m.All() {
+			if refs[i][k] != v || m.Rank(k) != rank {
+				t.Fatal("Wrong entry", i, k, v, refs[i][k])
+			}
+			rank++
+		}
+	}
+	// Writes through value pointers only affect the written version, also on the side of the original map.
+	var m = NewMap[int, int]()
+	for k := 0; k < 3; k++ {
+		m.Set(k, 10)
+	}
+	var s = m.Snapshot()
+	*m.GetZeroP(1) += 5
+	*m.With(3, 10).GetP(2) += 5
+	if v, _ := s.Get(1); v != 10 {
+		t.Fatal("Snapshot modified", v)
+	}
+	if v1, _ := m.Get(1); v1 != 15 {
+		t.Fatal("Wrong value", v1)
+	}
+	if v2, _ := m.Get(2); v2 != 10 {
+		t.Fatal("Original modified through With", v2)
+	}
+	// Only the first write after a snapshot copies the path.
+	if n := testing.AllocsPerRun(100, func() { m.Set(1, 20) }); n != 1 {
+		t.Fatal("Path copied again", n)
+	}
+	// Iterators continue after paths were copied by GetP during the iteration.
+	m = NewMap[int, int]()
+	for k := 0; k < 8; k++ {
+		m.Set(k, k)
+	}
+	m.Snapshot()
+	var keys []int
+	for it := m.Iterator(); it.Next(); {
+		keys = append(keys, it.Key)
+		if it.Key == 4 {
+			m.GetP(4)
+		}
+	}
+	if len(keys) != 8 || keys[7] != 7 {
+		t.Fatal("Wrong keys after copying during iteration", keys)
+	}
+	// Value pointers of iterators and queries refer to private values as well.
+	s = m.Snapshot()
+	for it := m.Iterator(); it.Next(); {
+		*it.Value += 100
+	}
+	var c = m.Cursor()
+	c.Jump(3)
+	*c.Value += 100
+	_, v, _ := m.Min()
+	*v += 100
+	_, v, _ = m.Select(5)
+	*v += 100
+	_, v, _ = m.Floor(6)
+	*v += 100
+	for k := 0; k < 8; k++ {
+		if v, _ := s.Get(k); v != k {
+			t.Fatal("Snapshot modified through value pointer", k, v)
+		}
+	}
+	if v0, _ := m.Get(0); v0 != 200 {
+		t.Fatal("Wrong value after writes through value pointers", v0)
+	}
+}
+
+func TestStructValues(t *testing.T) {
+	type point struct{ x, y int }
+	var m = NewMap[int8, point]()
+	for k := -128; k < 128; k++ {
+		m.Set(int8(k), point{k, -k})
+	}
+	if l := m.Length(); l != 256 {
+		t.Fatal("Wrong length", l)
+	}
+	var k = -128
+	for i := m.Iterator(); i.Next(); k++ {
+		if i.Key != int8(k) || *i.Value != (point{k, -k}) {
+			t.Fatal("Wrong key or value", k, i.Key, *i.Value)
+		}
+	}
+	if k != 128 {
+		t.Fatal("Wrong number of iterated keys", k+128)
+	}
+}
+
+func benchmarkInitMap() *Map[int, int] {
 	var rnd = rand.New(rand.NewSource(0))
-	var m = NewMapIntInt()
+	var m = NewMap[int, int]()
 	for i := 0; i < 10000; i++ {
 		var v = rnd.Intn(10000)
 		m.Set(v, v)
@@ -122,7 +690,7 @@ func benchmarkInitMap() *MapIntInt {
 	return m
 }
 
-var benchmarkSetGlobal *MapIntInt
+var benchmarkSetGlobal *Map[int, int]
 
 func BenchmarkSet(b *testing.B) {
 	for n := 0; n < b.N; n++ {
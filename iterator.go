@@ -0,0 +1,390 @@
+package critbit
+
+import (
+	"errors"
+)
+
+// ErrModified is the value of panics caused by using an iterator after keys were inserted into or removed from its map.
+var ErrModified = errors.New("critbit: map modified during iteration")
+
+// iterator implements the iterator shared by all map types. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+// Next and Prev panic with ErrModified if they are called on an invalid iterator. Stable iterators (cursors) stay
+// valid instead: after a modification, the next call to Next or Prev finds the next key higher or lower than the
+// key found before (or sought with Seek).
+type iterator[K any, E bitKey[E], C coder[K, E], V any] struct {
+	t        *tree[K, E, C, V]
+	root     *node[E, V]        // Root of the iterated subtree (nil if there is none)
+	subtree  func() *node[E, V] // Returns the current root of the iterated subtree, if only a subtree is iterated
+	nodes    []*node[E, V]
+	lastDir  int
+	mods     uint // Modification count of the map when the iterator became valid
+	copies   uint // Copy count of the map when the path of the iterator was found
+	stable   bool // Reposition instead of panicking after modifications (used by cursors)
+	readOnly bool // Value is not used for modifications, so shared values need not be copied
+	pos      E    // Key of the last position, used to reposition stable iterators
+	posGap   int  // Position relative to pos: like the gap argument of seek, 3 if at pos, 4 if at removed pos, -1 if there is no position
+	lo, hi   E    // Bounds of the iterated range [lo, hi)
+	hasLo    bool // Keys below lo are skipped
+	hasHi    bool // Keys at or above hi are skipped
+	Key      K    // Key found by last call to Next, Prev.
+	Value    *V   // Initially nil (also after calling Reset). Otherwise Pointer to value associated with key found by last call to Next, Prev (nil if no key was found).
+}
+
+func (i *iterator[K, E, C, V]) init(t *tree[K, E, C, V]) {
+	i.t = t
+	i.root = &t.root
+	i.Reset()
+}
+
+// Restrict the iterator to keys in the range [lo, hi). Unused bounds are ignored.
+func (i *iterator[K, E, C, V]) bound(lo, hi K, hasLo, hasHi bool) {
+	i.hasLo, i.hasHi = false, false
+	if hasHi {
+		i.setBound(hi, 1)
+	}
+	if hasLo {
+		i.setBound(lo, 0)
+	}
+}
+
+// Replace the upper (dir 1) or lower (dir 0) bound. Keys the coder can't represent (see checkedCoder)
+// are replaced by the nearest one, which is the same bound unless the key is above all keys.
+func (i *iterator[K, E, C, V]) setBound(key K, dir int) {
+	var k, beyond = i.t.codec.encode(key), i.t.beyond(key)
+	if dir == 1 {
+		// An upper bound above all keys excludes none.
+		i.hasHi, i.hi = beyond <= 0, k
+		return
+	}
+	i.hasLo, i.lo = true, k
+	if beyond > 0 {
+		// A lower bound above all keys excludes all of them, like the empty range [k, k).
+		i.hasHi, i.hi = true, k
+	}
+}
+
+// Return true if the key is outside of the bounds in the specified direction.
+func (i *iterator[K, E, C, V]) outside(key E, dir int) bool {
+	if dir == 1 {
+		return i.hasHi && !less(key, i.hi)
+	}
+	return i.hasLo && less(key, i.lo)
+}
+
+// Return true if there is nothing to iterate over.
+func (i *iterator[K, E, C, V]) empty() bool {
+	return i.root == nil || i.t.length == 0
+}
+
+// Seek initializes the iterator in a state that will be advanced to the specified key
+// on the next call to Prev or Next. If the key does not exist, the next call to Prev or Next
+// will advance the iterator to the next lower or higher key respectively (or the respective end of the map).
+func (i *iterator[K, E, C, V]) Seek(key K) {
+	i.seekKey(key, 2)
+}
+
+// SeekGE is the same as Seek, but if a bound is specified, Next stops before the first key at or above the bound.
+// The bound replaces any previous upper bound (like the one set by IterRange) and stays in effect until it is replaced.
+func (i *iterator[K, E, C, V]) SeekGE(key K, bound ...K) {
+	i.seekBounded(key, 2, 1, bound)
+}
+
+// SeekGT initializes the iterator in a state that will be advanced to the next key higher than the specified key
+// on the next call to Next, or to the next key lower than or equal to it on the next call to Prev.
+// If a bound is specified, Next stops before the first key at or above the bound (see SeekGE).
+func (i *iterator[K, E, C, V]) SeekGT(key K, bound ...K) {
+	i.seekBounded(key, 1, 1, bound)
+}
+
+// SeekLE is the same as Seek, but if a bound is specified, Prev stops after the last key below the bound.
+// The bound replaces any previous lower bound (like the one set by IterRange) and stays in effect until it is replaced.
+func (i *iterator[K, E, C, V]) SeekLE(key K, bound ...K) {
+	i.seekBounded(key, 2, 0, bound)
+}
+
+// SeekLT initializes the iterator in a state that will be advanced to the next key lower than the specified key
+// on the next call to Prev, or to the next key higher than or equal to it on the next call to Next.
+// If a bound is specified, Prev stops after the last key below the bound (see SeekLE).
+func (i *iterator[K, E, C, V]) SeekLT(key K, bound ...K) {
+	i.seekBounded(key, 0, 0, bound)
+}
+
+// Seek to the key and replace the bound in direction dir if one is specified.
+func (i *iterator[K, E, C, V]) seekBounded(key K, gap int, dir int, bound []K) {
+	if len(bound) > 0 {
+		i.setBound(bound[0], dir)
+	}
+	i.seekKey(key, gap)
+}
+
+// Seek to the key like seek. Keys the coder can't represent (see checkedCoder) are below or above all keys,
+// so the iterator is positioned in the gap before or after the nearest one.
+func (i *iterator[K, E, C, V]) seekKey(key K, gap int) {
+	if beyond := i.t.beyond(key); beyond != 0 {
+		gap = (beyond + 1) / 2
+	}
+	i.seek(i.t.codec.encode(key), gap)
+}
+
+// Position the iterator at the key (gap 2), or in the gap before (gap 0) or after (gap 1) it.
+func (i *iterator[K, E, C, V]) seek(k E, gap int) {
+	i.Reset()
+	if i.empty() {
+		i.pos, i.posGap = k, gap
+		return
+	}
+	// Walk down tree until leaf node is found or critical bit differs
+	var last = i.root
+	for last.crit != ^uint(0) && last.findCrit(k) == last.crit {
+		i.nodes = append(i.nodes, last)
+		last = &last.children()[last.dir(k)]
+	}
+	// Done if last node matches key
+	i.pos, i.posGap = k, gap
+	var crit, dir = k.crit(last.key), gap
+	if crit == ^uint(0) && gap == 2 {
+		i.nodes = append(i.nodes, last)
+		return
+	}
+	// No exact match for key found (or a gap next to it is wanted). Check if correct node would be left (smaller key)
+	// or right (larger key) of the last one.
+	if crit != ^uint(0) {
+		dir = k.bit(crit)
+	}
+	// Walk upwards until the shared parent of previous and next key is found.
+	for l := len(i.nodes); l > 0; l-- {
+		// The shared parent has the last removed node as a child on the opposite side of the direction where the correct node would be.
+		if &i.nodes[l-1].children()[1-dir] == last {
+			return
+		}
+		last = i.nodes[l-1]
+		i.nodes = i.nodes[0 : l-1]
+	}
+	// There are no other keys as high or low as the correct one. Simulate a Next or Prev call that reached the end
+	i.lastDir = dir
+}
+
+// Jump sets the iterator to the specified key, as if it had been found by a call to Next or Prev,
+// and returns true if the key exists (within the bounds of the iterator). Otherwise Value is set to nil and
+// the next call to Next or Prev advances the iterator like after Seek. Jump rebuilds the iterator state
+// from the current map, so it can be used to continue an iteration after the map was modified,
+// for example with Jump(i.Key).
+func (i *iterator[K, E, C, V]) Jump(key K) bool {
+	var k = i.t.codec.encode(key)
+	i.seekKey(key, 2)
+	if i.t.beyond(key) != 0 || len(i.nodes) == 0 || i.lastDir != 2 || i.outside(k, 0) || i.outside(k, 1) {
+		return false
+	}
+	if i.nodes[len(i.nodes)-1].crit != ^uint(0) {
+		return false
+	}
+	i.own(1)
+	var leaf = i.nodes[len(i.nodes)-1]
+	i.lastDir = 1
+	i.posGap = 3
+	i.Key = i.t.codec.decode(leaf.key)
+	i.Value = leaf.value()
+	return true
+}
+
+// First sets the iterator to the lowest key (within the bounds of the iterator) and returns true
+// unless there is no such key. It can be used like Jump after the map was modified.
+func (i *iterator[K, E, C, V]) First() bool {
+	i.Reset()
+	return i.Next()
+}
+
+// Last sets the iterator to the highest key (within the bounds of the iterator) and returns true
+// unless there is no such key. It can be used like Jump after the map was modified.
+func (i *iterator[K, E, C, V]) Last() bool {
+	i.Reset()
+	return i.Prev()
+}
+
+// Rem removes the entry found by the last call to Next or Prev from the map. The iterator stays valid and the
+// next call to Next or Prev advances it to the next higher or lower key respectively. Value is set to nil.
+// Rem does nothing if the last call to Next or Prev did not find a key.
+func (i *iterator[K, E, C, V]) Rem() {
+	if i.mods != i.t.mods && !i.stable {
+		panic(ErrModified)
+	}
+	if i.Value == nil {
+		return
+	}
+	var key, k = i.Key, i.pos
+	// The key of a stable iterator may already have been removed.
+	if i.t.length > 0 {
+		i.t.rem(k)
+	}
+	// Position the iterator in the gap left by the removed key. The removal may have replaced the nodes of the
+	// subtree, so seek finds its root again.
+	i.seek(k, 2)
+	i.posGap = 4
+	i.Key = key
+}
+
+// Reset restores the iterator to the initial state.
+func (i *iterator[K, E, C, V]) Reset() {
+	var zero K
+	i.Key = zero
+	i.Value = nil
+	i.lastDir = 2
+	i.mods = i.t.mods
+	i.copies = i.t.copies
+	i.posGap = -1
+	if i.subtree != nil {
+		i.root = i.subtree()
+	}
+	if i.nodes == nil {
+		i.nodes = make([]*node[E, V], 0, 64)
+	} else {
+		i.nodes = i.nodes[0:0]
+	}
+}
+
+// Next advances the iterator to the next higher key and populates the iterators public Fields.
+// If the iterator is in the initial state, the first call to Next will set the iterator to the lowest key.
+// The return value is true unless there is no next higher key to advance to.
+func (i *iterator[K, E, C, V]) Next() bool {
+	i.move(1)
+	return i.Value != nil
+}
+
+// Prev advances the iterator to the next lower key and populates the iterators public Fields.
+// If the iterator is in the initial state, the first call to Prev will set the iterator to the highest key.
+// The return value is true unless there is no next lower key to advance to.
+func (i *iterator[K, E, C, V]) Prev() bool {
+	i.move(0)
+	return i.Value != nil
+}
+
+// Take one step like step, but skip keys outside of the bounds.
+func (i *iterator[K, E, C, V]) move(dir int) {
+	if i.mods != i.t.mods {
+		if !i.stable {
+			panic(ErrModified)
+		}
+		i.resync(dir)
+	} else if i.copies != i.t.copies {
+		// Nodes on the path of the iterator may have been replaced by copies.
+		i.resync(dir)
+	}
+	var pos, posGap = i.pos, i.posGap
+	i.step(dir)
+	if i.Value == nil || !i.hasLo && !i.hasHi {
+		return
+	}
+	// If the step started before the range (from the map end or a Seek), continue at the bound.
+	if i.outside(i.nodes[len(i.nodes)-1].key, 1-dir) {
+		if dir == 1 {
+			i.seek(i.lo, 2)
+		} else {
+			i.seek(i.hi, 0)
+		}
+		i.step(dir)
+		if i.Value == nil {
+			return
+		}
+	}
+	// Behave like the end of the map if the step left the range.
+	if i.outside(i.nodes[len(i.nodes)-1].key, dir) {
+		i.nodes = i.nodes[0:0]
+		i.Value = nil
+		i.lastDir = dir
+		i.pos, i.posGap = pos, posGap
+	}
+}
+
+// Rebuild the state of a stable iterator after the map was modified, so that the next step in direction dir
+// finds the same key as it would have without the modification, or the next one if that key was removed.
+func (i *iterator[K, E, C, V]) resync(dir int) {
+	switch {
+	case i.posGap == 4:
+		// Gap left by Rem. If the removed key was the highest or lowest one, seek simulated an end of the map,
+		// but keys inserted on either side must still be found.
+		i.seek(i.pos, dir)
+	case i.posGap < 0 || i.Value == nil && i.lastDir != 2 && i.lastDir != dir:
+		// Initial state or end of map in the opposite direction.
+		i.Reset()
+	case i.posGap == 3:
+		i.seek(i.pos, dir)
+	default:
+		i.seek(i.pos, i.posGap)
+	}
+}
+
+// Copy the path to the leaf the iterator is at if the leaf is shared with another tree (see tree.ownValue). The
+// iterator is positioned at the copy as if it had been found by a step in direction dir.
+func (i *iterator[K, E, C, V]) own(dir int) {
+	if i.readOnly || i.t.owner == 0 || i.t.owner == frozen {
+		return
+	}
+	// The whole path belongs to the tree if the branch of the leaf does, because share changes the owner tag of all
+	// branches at once. A leaf at the root of the tree is never shared.
+	var l = len(i.nodes)
+	if l > 1 && (*branch[E, V])(i.nodes[l-2].child).owner == i.t.owner || l == 1 && i.nodes[0] == &i.t.root {
+		return
+	}
+	var k = i.nodes[l-1].key
+	i.t.own(k)
+	i.seek(k, 2)
+	i.lastDir = dir
+}
+
+func (i *iterator[K, E, C, V]) step(dir int) {
+	// Check if iterator is at some node from a Seek, a leaf from step or at an end
+	if len(i.nodes) == 0 {
+		// Iterator is at end of map.
+		if i.lastDir != dir && !i.empty() {
+			// Direction changed or not defined yet. Use root as starting point.
+			i.lastDir = dir
+			i.nodes = append(i.nodes, i.root)
+		} else {
+			// End of map.
+			i.Value = nil
+			return
+		}
+	} else if i.lastDir == 2 {
+		// At node from Seek. Stay if this is a leaf. Otherwise take one step in the direction of dir.
+		if current := i.nodes[len(i.nodes)-1]; current.crit != ^uint(0) {
+			i.nodes = append(i.nodes, &current.children()[dir])
+		}
+		i.lastDir = dir
+	} else {
+		// Iterator is at some leaf from previous call to step. Comments describe behavior with dir == 1 (left to right).
+		// Go up until we are at a left child. Then go to the sibling.
+		for {
+			// Check if there is a parent
+			if len(i.nodes) == 1 {
+				// No parent. Set end of map state.
+				i.nodes = i.nodes[0:0]
+				i.Value = nil
+				i.lastDir = dir
+				return
+			}
+			// If current node is left, replace it with the right one and stop going up.
+			var rigthChild = &i.nodes[len(i.nodes)-2].children()[dir]
+			if rigthChild != i.nodes[len(i.nodes)-1] {
+				i.nodes[len(i.nodes)-1] = rigthChild
+				break
+			}
+			// Go up
+			i.nodes[len(i.nodes)-1] = nil // Help gc
+			i.nodes = i.nodes[0 : len(i.nodes)-1]
+		}
+	}
+	// Find next leaf by walking in correct direction. Comments describe behavior with dir == 1 (left to right).
+	// Go left until next leaf is found.
+	var current = i.nodes[len(i.nodes)-1]
+	for current.crit != ^uint(0) {
+		current = &current.children()[1-dir]
+		i.nodes = append(i.nodes, current)
+	}
+	// Found leaf. Copy its path if it is shared, so that Value can be used for modifications. Store data.
+	i.own(dir)
+	current = i.nodes[len(i.nodes)-1]
+	i.pos, i.posGap = current.key, 3
+	i.Key = i.t.codec.decode(current.key)
+	i.Value = current.value()
+}
@@ -0,0 +1,140 @@
+package critbit
+
+import (
+	"encoding/binary"
+	"net/netip"
+)
+
+// RouteTable implements an associative array of V indexed by IP prefixes, which supports longest prefix
+// matching of addresses. IPv4 and IPv6 prefixes are kept separately, so IPv4 addresses only match IPv4
+// prefixes and IPv4-mapped IPv6 addresses only match IPv6 prefixes. The zero value is an empty table ready to use.
+type RouteTable[V any] struct {
+	v4, v6 tree[netip.Prefix, routeKey, routeCoder, V]
+}
+
+// NewRouteTable returns a new route table with values of type V.
+func NewRouteTable[V any]() *RouteTable[V] {
+	var r RouteTable[V]
+	return &r
+}
+
+func (t *RouteTable[V]) table(addr netip.Addr) *tree[netip.Prefix, routeKey, routeCoder, V] {
+	if addr.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+// Insert inserts or replaces the value associated with the specified prefix.
+// Bits of the address after the prefix length are ignored. Invalid prefixes are ignored.
+func (t *RouteTable[V]) Insert(prefix netip.Prefix, val V) {
+	if prefix.IsValid() {
+		t.table(prefix.Addr()).Set(prefix, val)
+	}
+}
+
+// Delete removes the value associated with the specified prefix.
+func (t *RouteTable[V]) Delete(prefix netip.Prefix) {
+	if prefix.IsValid() {
+		t.table(prefix.Addr()).Rem(prefix)
+	}
+}
+
+// GetP returns the internal pointer to the value associated with exactly the specified prefix.
+// If there is no such prefix it returns nil.
+func (t *RouteTable[V]) GetP(prefix netip.Prefix) *V {
+	if !prefix.IsValid() {
+		return nil
+	}
+	return t.table(prefix.Addr()).GetP(prefix)
+}
+
+// Get returns the value associated with exactly the specified prefix and true if the prefix exists.
+// Otherwise the zero value and false are returned.
+func (t *RouteTable[V]) Get(prefix netip.Prefix) (V, bool) {
+	if !prefix.IsValid() {
+		var zero V
+		return zero, false
+	}
+	return t.table(prefix.Addr()).Get(prefix)
+}
+
+// Lookup returns the longest prefix containing the specified address and the internal pointer to its value.
+// The last return value is false if there is no such prefix.
+func (t *RouteTable[V]) Lookup(addr netip.Addr) (netip.Prefix, *V, bool) {
+	if !addr.IsValid() {
+		return netip.Prefix{}, nil, false
+	}
+	var tbl = t.table(addr)
+	if n := longestPrefixOf(tbl, tbl.codec.encode(netip.PrefixFrom(addr, addr.BitLen()))); n != nil {
+		return tbl.codec.decode(n.key), tbl.ownValue(n), true
+	}
+	return netip.Prefix{}, nil, false
+}
+
+// Length returns the number of distinct prefixes in the table.
+func (t *RouteTable[V]) Length() int {
+	return t.v4.length + t.v6.length
+}
+
+// routeKey is the internal representation of IP prefixes. Each bit of the address is preceded by a presence bit.
+// IPv4 addresses are stored in the first 32 bits of addr. Bits after the prefix length are 0.
+type routeKey struct {
+	addr Uint128
+	bits uint
+	is4  bool
+}
+
+func (k routeKey) crit(o routeKey) uint {
+	var n = min(k.bits, o.bits)
+	if crit := k.addr.crit(o.addr); crit < n {
+		return 2*crit + 1
+	}
+	if k.bits == o.bits {
+		return ^uint(0)
+	}
+	return 2 * n
+}
+
+func (k routeKey) bit(pos uint) int {
+	if pos/2 >= k.bits {
+		return 0
+	}
+	if pos%2 == 0 {
+		return 1
+	}
+	return k.addr.bit(pos / 2)
+}
+
+func (k routeKey) end() uint {
+	return 2 * k.bits
+}
+
+func (k routeKey) presence(pos uint) bool {
+	return pos%2 == 0
+}
+
+type routeCoder struct{}
+
+func (routeCoder) encode(prefix netip.Prefix) routeKey {
+	prefix = prefix.Masked()
+	var addr = prefix.Addr()
+	if addr.Is4() {
+		var a = addr.As4()
+		return routeKey{Uint128{uint64(binary.BigEndian.Uint32(a[:])) << 32, 0}, uint(prefix.Bits()), true}
+	}
+	var a = addr.As16()
+	return routeKey{Uint128{binary.BigEndian.Uint64(a[:]), binary.BigEndian.Uint64(a[8:])}, uint(prefix.Bits()), false}
+}
+
+func (routeCoder) decode(key routeKey) netip.Prefix {
+	if key.is4 {
+		var a [4]byte
+		binary.BigEndian.PutUint32(a[:], uint32(key.addr.Hi>>32))
+		return netip.PrefixFrom(netip.AddrFrom4(a), int(key.bits))
+	}
+	var a [16]byte
+	binary.BigEndian.PutUint64(a[:], key.addr.Hi)
+	binary.BigEndian.PutUint64(a[8:], key.addr.Lo)
+	return netip.PrefixFrom(netip.AddrFrom16(a), int(key.bits))
+}
@@ -0,0 +1,53 @@
+package critbit
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestRouteTable(t *testing.T) {
+	var r RouteTable[string]
+	for _, p := range []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.3/32", "10.128.0.0/9", "192.168.1.0/24", "::/0", "2001:db8::/32", "2001:db8:1::/48", "::ffff:10.0.0.0/104"} {
+		r.Insert(netip.MustParsePrefix(p), p)
+	}
+	// Host bits are ignored
+	r.Insert(netip.MustParsePrefix("172.16.5.5/12"), "172.16.0.0/12")
+	if l := r.Length(); l != 11 {
+		t.Fatal("Wrong length", l)
+	}
+	for addr, want := range map[string]string{
+		"10.1.2.3":        "10.1.2.3/32",
+		"10.1.2.4":        "10.1.0.0/16",
+		"10.2.0.0":        "10.0.0.0/8",
+		"10.200.0.1":      "10.128.0.0/9",
+		"11.0.0.0":        "0.0.0.0/0",
+		"172.31.255.255":  "172.16.0.0/12",
+		"192.168.1.255":   "192.168.1.0/24",
+		"192.168.2.0":     "0.0.0.0/0",
+		"2001:db8:1:2::1": "2001:db8:1::/48",
+		"2001:db8:2::1":   "2001:db8::/32",
+		"2001:db9::":      "::/0",
+		"::ffff:10.1.2.3": "::ffff:10.0.0.0/104",
+		"::ffff:11.1.2.3": "::/0",
+	} {
+		var p, v, ok = r.Lookup(netip.MustParseAddr(addr))
+		if !ok || *v != want || p.String() != want {
+			t.Fatal("Wrong route", addr, p, want)
+		}
+	}
+
+	r.Delete(netip.MustParsePrefix("0.0.0.0/0"))
+	r.Delete(netip.MustParsePrefix("10.1.0.0/16"))
+	if p, _, ok := r.Lookup(netip.MustParseAddr("11.0.0.0")); ok {
+		t.Fatal("Deleted default route found", p)
+	}
+	if p, _, _ := r.Lookup(netip.MustParseAddr("10.1.2.4")); p.String() != "10.0.0.0/8" {
+		t.Fatal("Wrong route after Delete", p)
+	}
+	if v, ok := r.Get(netip.MustParsePrefix("10.128.0.0/9")); !ok || v != "10.128.0.0/9" {
+		t.Fatal("Wrong exact match", v, ok)
+	}
+	if _, ok := r.Get(netip.MustParsePrefix("10.128.0.0/10")); ok {
+		t.Fatal("Exact match found for non-existent prefix")
+	}
+}
@@ -0,0 +1,72 @@
+package critbit
+
+import (
+	"iter"
+)
+
+// Return a sequence of the entries in the range [lo, hi) (bounds are only used if hasLo or hasHi are true)
+// in ascending (dir 1) or descending (dir 0) order.
+func (t *tree[K, E, C, V]) entries(lo, hi K, hasLo, hasHi bool, dir int) iter.Seq2[K, *V] {
+	return func(yield func(K, *V) bool) {
+		var i iterator[K, E, C, V]
+		i.init(t)
+		i.readOnly = true
+		i.bound(lo, hi, hasLo, hasHi)
+		for i.move(dir); i.Value != nil; i.move(dir) {
+			if !yield(i.Key, i.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Return a sequence of all entries with values instead of value pointers.
+func (t *tree[K, E, C, V]) pairs(lo, hi K, hasLo, hasHi bool, dir int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range t.entries(lo, hi, hasLo, hasHi, dir) {
+			if !yield(k, *v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all entries of the map in ascending order of their keys.
+// Like the iterator returned by Iterator, it becomes invalid if the map is modified during the iteration.
+func (t *tree[K, E, C, V]) All() iter.Seq2[K, V] {
+	var zero K
+	return t.pairs(zero, zero, false, false, 1)
+}
+
+// Backward returns an iterator over all entries of the map in descending order of their keys.
+func (t *tree[K, E, C, V]) Backward() iter.Seq2[K, V] {
+	var zero K
+	return t.pairs(zero, zero, false, false, 0)
+}
+
+// Range returns an iterator over the entries with keys in the range [lo, hi) in ascending order of their keys.
+func (t *tree[K, E, C, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return t.pairs(lo, hi, true, true, 1)
+}
+
+// Keys returns an iterator over all keys of the map in ascending order.
+func (t *tree[K, E, C, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range t.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the map in ascending order of their keys.
+func (t *tree[K, E, C, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range t.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package critbit
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	var m MapString[int]
+	for i, k := range []string{"d", "b", "a", "c", "e"} {
+		m.Set(k, i)
+	}
+	if keys := slices.Collect(m.Keys()); !slices.Equal(keys, []string{"a", "b", "c", "d", "e"}) {
+		t.Fatal("Wrong keys", keys)
+	}
+	if values := slices.Collect(m.Values()); !slices.Equal(values, []int{2, 1, 3, 0, 4}) {
+		t.Fatal("Wrong values", values)
+	}
+	var keys []string
+	for k, v := range m.Backward() {
+		if w, _ := m.Get(k); v != w {
+			t.Fatal("Wrong value", k, v, w)
+		}
+		keys = append(keys, k)
+	}
+	if !slices.Equal(keys, []string{"e", "d", "c", "b", "a"}) {
+		t.Fatal("Wrong keys from Backward", keys)
+	}
+	keys = keys[:0]
+	for k := range m.Range("b", "d") {
+		keys = append(keys, k)
+	}
+	if !slices.Equal(keys, []string{"b", "c"}) {
+		t.Fatal("Wrong keys from Range", keys)
+	}
+	// Stop early
+	for k := range m.All() {
+		if k != "a" {
+			t.Fatal("Wrong first key", k)
+		}
+		break
+	}
+}
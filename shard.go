@@ -0,0 +1,143 @@
+package critbit
+
+import (
+	"iter"
+	"sync"
+	"unsafe"
+)
+
+// ShardedMap implements an associative array of V indexed by integers of type K that is safe for concurrent use by
+// multiple goroutines. The key space is split into 2^N contiguous ranges by the highest N bits of the keys, and each
+// range is stored in a separately locked shard, so that writers of keys in different shards don't block each other.
+// Shards are selected by the highest bits of the keys, which works best for keys spread across the whole range of K.
+// Maps must be created with NewShardedMap.
+type ShardedMap[K Integer, V any] struct {
+	shift  uint // Number of low bits of a key aligned to 64 bits that don't select the shard
+	shards []shard[K, V]
+}
+
+type shard[K Integer, V any] struct {
+	mutex sync.RWMutex
+	m     Map[K, V]
+}
+
+// NewShardedMap returns a new map with keys of type K and values of type V that is split into 2^bits shards.
+// It panics if bits is greater than 16.
+func NewShardedMap[K Integer, V any](bits uint) *ShardedMap[K, V] {
+	if bits > 16 {
+		panic("critbit: too many shard bits")
+	}
+	return &ShardedMap[K, V]{shift: 64 - bits, shards: make([]shard[K, V], 1<<bits)}
+}
+
+// Return the index of the shard containing the key. The highest bit of the key is moved to the highest bit of
+// an uint64 and flipped for signed keys, so that the shards are ordered like their keys.
+func (m *ShardedMap[K, V]) index(key K) int {
+	var k = uint64(key) << (64 - 8*unsafe.Sizeof(key))
+	if ^K(0) < 0 {
+		k ^= 1 << 63
+	}
+	return int(k >> m.shift)
+}
+
+// Get returns the value associated with the specified key and true if the key exists.
+// Otherwise the zero value and false are returned.
+func (m *ShardedMap[K, V]) Get(key K) (V, bool) {
+	var s = &m.shards[m.index(key)]
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.m.Get(key)
+}
+
+// Set inserts or replaces the value associated with the specified key.
+func (m *ShardedMap[K, V]) Set(key K, val V) {
+	var s = &m.shards[m.index(key)]
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.m.Set(key, val)
+}
+
+// Rem removes the value associated with the specified key from the map.
+func (m *ShardedMap[K, V]) Rem(key K) {
+	m.Take(key)
+}
+
+// Take removes the value associated with the specified key from the map and returns it and true if the key existed.
+// Otherwise the zero value and false are returned.
+func (m *ShardedMap[K, V]) Take(key K) (V, bool) {
+	var s = &m.shards[m.index(key)]
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.m.Take(key)
+}
+
+// Update calls f with the value associated with the specified key and true, or the zero value and false
+// if the key does not exist. If f returns true, the value returned by f is associated with the key.
+// Otherwise the key is removed from the map. The shard of the key is locked while f is called,
+// so f must not use the map.
+func (m *ShardedMap[K, V]) Update(key K, f func(old V, exists bool) (new V, keep bool)) {
+	var s = &m.shards[m.index(key)]
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.m.Update(key, f)
+}
+
+// Length returns the number of distinct keys in the map. Keys inserted or removed concurrently may or may not be counted.
+func (m *ShardedMap[K, V]) Length() int {
+	var length = 0
+	for i := range m.shards {
+		var s = &m.shards[i]
+		s.mutex.RLock()
+		length += s.m.Length()
+		s.mutex.RUnlock()
+	}
+	return length
+}
+
+// Return the entry with the lowest key at or above (if inclusive is true) or above the specified key.
+func (s *shard[K, V]) next(key K, inclusive bool) (K, V, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	var k, val, ok = s.m.Higher(key)
+	if inclusive {
+		k, val, ok = s.m.Ceiling(key)
+	}
+	if !ok {
+		var zero V
+		return k, zero, false
+	}
+	return k, *val, true
+}
+
+// Return a sequence of the entries with keys at or above lo (and below hi if hasHi is true) in ascending order.
+func (m *ShardedMap[K, V]) entries(lo, hi K, hasHi bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		// Shards are contiguous ranges of keys, so the lowest key at or above lo in a later shard is its minimum.
+		for i := m.index(lo); i < len(m.shards); i++ {
+			var s = &m.shards[i]
+			for k, v, ok := s.next(lo, true); ok; k, v, ok = s.next(k, false) {
+				if hasHi && k >= hi || !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All returns an iterator over all entries of the map in ascending order of their keys. No lock is held while
+// the loop body runs, so it may modify the map. Each key is visited at most once, but keys that are inserted or
+// removed during the iteration may or may not be visited.
+func (m *ShardedMap[K, V]) All() iter.Seq2[K, V] {
+	// The lowest key is 0 for unsigned keys and has only the sign bit set for signed keys.
+	var lo K
+	if ^K(0) < 0 {
+		lo = 1 << (8*unsafe.Sizeof(lo) - 1)
+	}
+	return m.entries(lo, 0, false)
+}
+
+// Range returns an iterator over the entries with keys in the range [lo, hi) in ascending order of their keys.
+// Like All, it may be used while the map is modified.
+func (m *ShardedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return m.entries(lo, hi, true)
+}
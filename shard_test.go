@@ -0,0 +1,108 @@
+package critbit
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestShardedMap(t *testing.T) {
+	var m = NewShardedMap[uint64, uint64](4)
+	var wg sync.WaitGroup
+	for g := uint64(0); g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var r = rand.New(rand.NewSource(int64(g)))
+			for i := 0; i < 1000; i++ {
+				var k = r.Uint64()
+				m.Set(k, k)
+				if i%2 == 0 {
+					m.Rem(k)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	var n, last = 0, uint64(0)
+	for k, v := range m.All() {
+		if n > 0 && k <= last || k != v {
+			t.Fatal("Wrong order or value", k, last, v)
+		}
+		last = k
+		n++
+	}
+	if n != 4000 || m.Length() != 4000 {
+		t.Fatal("Wrong length", n, m.Length())
+	}
+}
+
+func TestShardedMapRange(t *testing.T) {
+	var m = NewShardedMap[int64, int](2)
+	var keys = []int64{math.MinInt64, -1 << 62, -5, 0, 3, 1 << 62, math.MaxInt64}
+	for i, k := range keys {
+		m.Set(k, i)
+	}
+	var i = 0
+	for k, v := range m.All() {
+		if k != keys[i] || v != i {
+			t.Fatal("Wrong entry", k, v, i)
+		}
+		// Removing keys during the iteration is allowed.
+		m.Rem(k)
+		i++
+	}
+	if i != len(keys) || m.Length() != 0 {
+		t.Fatal("Wrong number of entries", i, m.Length())
+	}
+	for i, k := range keys {
+		m.Set(k, i)
+	}
+	i = 2
+	for k := range m.Range(-5, 1<<62) {
+		if k != keys[i] {
+			t.Fatal("Wrong key in range", k, keys[i])
+		}
+		i++
+	}
+	if i != 5 {
+		t.Fatal("Wrong number of keys in range", i)
+	}
+	m.Update(3, func(v int, ok bool) (int, bool) { return v + 10, ok })
+	if v, ok := m.Get(3); !ok || v != 14 {
+		t.Fatal("Wrong value after Update", v, ok)
+	}
+}
+
+func TestShardedMapSmallKeys(t *testing.T) {
+	// Keys spread across the range of a small type use all shards.
+	var m = NewShardedMap[uint32, int](4)
+	for i := uint32(0); i < 16; i++ {
+		m.Set(i<<28|i, int(i))
+	}
+	for i := range m.shards {
+		if l := m.shards[i].m.Length(); l != 1 {
+			t.Fatal("Wrong shard length", i, l)
+		}
+	}
+	var s = NewShardedMap[int8, int](2)
+	for k := math.MinInt8; k <= math.MaxInt8; k++ {
+		s.Set(int8(k), k)
+	}
+	for i := range s.shards {
+		if l := s.shards[i].m.Length(); l != 64 {
+			t.Fatal("Wrong shard length", i, l)
+		}
+	}
+	var next = math.MinInt8
+	for k, v := range s.All() {
+		if int(k) != next || v != next {
+			t.Fatal("Wrong entry", k, v, next)
+		}
+		next++
+	}
+	if next != math.MaxInt8+1 {
+		t.Fatal("Wrong number of entries", next)
+	}
+}
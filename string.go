@@ -0,0 +1,311 @@
+package critbit
+
+import (
+	"math/bits"
+)
+
+// MapString implements an associative array of V indexed by strings.
+// Keys are ordered lexicographically by their bytes. The zero value is an empty map ready to use.
+type MapString[V any] struct {
+	tree[string, strKey, stringCoder, V]
+}
+
+// NewMapString returns a new map with keys of type string and values of type V.
+func NewMapString[V any]() *MapString[V] {
+	var r MapString[V]
+	return &r
+}
+
+// StringIterator iterates over the entries of a MapString in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type StringIterator[V any] struct {
+	iterator[string, strKey, stringCoder, V]
+}
+
+// Iterator returns a new StringIterator.
+func (t *MapString[V]) Iterator() *StringIterator[V] {
+	var i StringIterator[V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new StringIterator that only visits keys in the range [lo, hi).
+func (t *MapString[V]) IterRange(lo, hi string) *StringIterator[V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// StringCursor iterates over the entries of a MapString like a StringIterator, but stays valid if the map is modified.
+type StringCursor[V any] struct {
+	iterator[string, strKey, stringCoder, V]
+}
+
+// Cursor returns a new StringCursor.
+func (t *MapString[V]) Cursor() *StringCursor[V] {
+	var c StringCursor[V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapString[V]) Snapshot() *MapString[V] {
+	return &MapString[V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapString[V]) With(key string, val V) *MapString[V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapString[V]) Without(key string) *MapString[V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapString is a MapString that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapString[V any] struct {
+	atomicMap[MapString[V], *MapString[V], string, V]
+}
+
+// MapStringTxn is a transaction of an AtomicMapString.
+type MapStringTxn[V any] struct {
+	txn[MapString[V], *MapString[V], string, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapString[V]) Begin() *MapStringTxn[V] {
+	return &MapStringTxn[V]{a.begin()}
+}
+
+// IterPrefix returns a new StringIterator that only visits keys starting with prefix.
+func (t *MapString[V]) IterPrefix(prefix string) *StringIterator[V] {
+	var i = t.Iterator()
+	i.subtree = func() *node[strKey, V] { return prefixNode(&t.tree, strKey(prefix)) }
+	i.Reset()
+	return i
+}
+
+// CountPrefix returns the number of keys starting with prefix.
+func (t *MapString[V]) CountPrefix(prefix string) int {
+	if n := prefixNode(&t.tree, strKey(prefix)); n != nil {
+		return n.size()
+	}
+	return 0
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s (or equal to s) and the internal pointer to its value.
+// The last return value is false if there is no such key.
+func (t *MapString[V]) LongestPrefixOf(s string) (string, *V, bool) {
+	if n := longestPrefixOf(&t.tree, strKey(s)); n != nil {
+		return string(n.key), t.ownValue(n), true
+	}
+	return "", nil, false
+}
+
+// LongestCommonPrefix returns the longest prefix shared by all keys in the map.
+func (t *MapString[V]) LongestCommonPrefix() string {
+	return string(longestCommonPrefix(&t.tree))
+}
+
+// MapBytes implements an associative array of V indexed by byte slices.
+// Keys are copied on insertion and ordered lexicographically. The zero value is an empty map ready to use.
+type MapBytes[V any] struct {
+	tree[[]byte, strKey, bytesCoder, V]
+}
+
+// NewMapBytes returns a new map with keys of type []byte and values of type V.
+func NewMapBytes[V any]() *MapBytes[V] {
+	var r MapBytes[V]
+	return &r
+}
+
+// BytesIterator iterates over the entries of a MapBytes in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+// The Key field is a copy and may be modified by the caller.
+type BytesIterator[V any] struct {
+	iterator[[]byte, strKey, bytesCoder, V]
+}
+
+// Iterator returns a new BytesIterator.
+func (t *MapBytes[V]) Iterator() *BytesIterator[V] {
+	var i BytesIterator[V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new BytesIterator that only visits keys in the range [lo, hi).
+func (t *MapBytes[V]) IterRange(lo, hi []byte) *BytesIterator[V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// BytesCursor iterates over the entries of a MapBytes like a BytesIterator, but stays valid if the map is modified.
+type BytesCursor[V any] struct {
+	iterator[[]byte, strKey, bytesCoder, V]
+}
+
+// Cursor returns a new BytesCursor.
+func (t *MapBytes[V]) Cursor() *BytesCursor[V] {
+	var c BytesCursor[V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapBytes[V]) Snapshot() *MapBytes[V] {
+	return &MapBytes[V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapBytes[V]) With(key []byte, val V) *MapBytes[V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapBytes[V]) Without(key []byte) *MapBytes[V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapBytes is a MapBytes that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapBytes[V any] struct {
+	atomicMap[MapBytes[V], *MapBytes[V], []byte, V]
+}
+
+// MapBytesTxn is a transaction of an AtomicMapBytes.
+type MapBytesTxn[V any] struct {
+	txn[MapBytes[V], *MapBytes[V], []byte, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapBytes[V]) Begin() *MapBytesTxn[V] {
+	return &MapBytesTxn[V]{a.begin()}
+}
+
+// IterPrefix returns a new BytesIterator that only visits keys starting with prefix.
+func (t *MapBytes[V]) IterPrefix(prefix []byte) *BytesIterator[V] {
+	var i = t.Iterator()
+	i.subtree = func() *node[strKey, V] { return prefixNode(&t.tree, strKey(prefix)) }
+	i.Reset()
+	return i
+}
+
+// CountPrefix returns the number of keys starting with prefix.
+func (t *MapBytes[V]) CountPrefix(prefix []byte) int {
+	if n := prefixNode(&t.tree, strKey(prefix)); n != nil {
+		return n.size()
+	}
+	return 0
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s (or equal to s) and the internal pointer to its value.
+// The last return value is false if there is no such key.
+func (t *MapBytes[V]) LongestPrefixOf(s []byte) ([]byte, *V, bool) {
+	if n := longestPrefixOf(&t.tree, strKey(s)); n != nil {
+		return []byte(n.key), t.ownValue(n), true
+	}
+	return nil, nil, false
+}
+
+// LongestCommonPrefix returns the longest prefix shared by all keys in the map.
+func (t *MapBytes[V]) LongestCommonPrefix() []byte {
+	return []byte(longestCommonPrefix(&t.tree))
+}
+
+// strKey is the internal representation of variable length keys. Each byte of the key is represented by
+// 9 bits: a leading 1 bit marking the presence of the byte, followed by the 8 bits of the byte. All bits
+// after the end of the key are 0, which orders keys before any longer key they are a prefix of.
+type strKey string
+
+func (k strKey) crit(o strKey) uint {
+	var n = min(len(k), len(o))
+	for i := 0; i < n; i++ {
+		if x := k[i] ^ o[i]; x != 0 {
+			return uint(i)*9 + 1 + uint(bits.LeadingZeros8(x))
+		}
+	}
+	if len(k) == len(o) {
+		return ^uint(0)
+	}
+	return uint(n) * 9
+}
+
+func (k strKey) bit(pos uint) int {
+	var i, j = pos / 9, pos % 9
+	if i >= uint(len(k)) {
+		return 0
+	}
+	if j == 0 {
+		return 1
+	}
+	return int(k[i]>>(8-j)) & 1
+}
+
+func (k strKey) end() uint {
+	return uint(len(k)) * 9
+}
+
+func (k strKey) presence(pos uint) bool {
+	return pos%9 == 0
+}
+
+type stringCoder struct{}
+
+func (stringCoder) encode(key string) strKey {
+	return strKey(key)
+}
+
+func (stringCoder) decode(key strKey) string {
+	return string(key)
+}
+
+type bytesCoder struct{}
+
+func (bytesCoder) encode(key []byte) strKey {
+	return strKey(key)
+}
+
+func (bytesCoder) decode(key strKey) []byte {
+	return []byte(key)
+}
+
+// prefixNode returns the root of the subtree that contains all keys starting with prefix.
+// If there are no such keys, nil is returned.
+func prefixNode[K any, C coder[K, strKey], V any](t *tree[K, strKey, C, V], prefix strKey) *node[strKey, V] {
+	if t.length == 0 {
+		return nil
+	}
+	// Walk down until the critical bit is behind the prefix. All keys below share the prefix of the node key.
+	var end = uint(len(prefix)) * 9
+	var n = &t.root
+	for n.crit < end {
+		n = &n.children()[n.dir(prefix)]
+	}
+	if len(n.key) < len(prefix) || n.key[:len(prefix)] != prefix {
+		return nil
+	}
+	return n
+}
+
+// longestCommonPrefix returns the longest prefix shared by all keys in the tree.
+func longestCommonPrefix[K any, C coder[K, strKey], V any](t *tree[K, strKey, C, V]) strKey {
+	switch {
+	case t.length == 0:
+		return ""
+	case t.root.crit == ^uint(0):
+		return t.root.key
+	default:
+		return t.root.key[:t.root.crit/9]
+	}
+}
@@ -0,0 +1,125 @@
+package critbit
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	const L = 10000
+	var ref = make([]string, 0, L)
+	var m MapString[int]
+	// Short random keys produce many keys that are prefixes of others
+	for len(ref) < L {
+		var key = strconv.FormatUint(rand.Uint64()%100000, 36)
+		if _, ok := m.Get(key); ok {
+			continue
+		}
+		ref = append(ref, key)
+		m.Set(key, len(key))
+	}
+	m.Set("", 0)
+	ref = append(ref, "")
+
+	// Remove half of the entries
+	for _, key := range ref[len(ref)/2:] {
+		m.Rem(key)
+	}
+	ref = ref[:len(ref)/2]
+	if m.Length() != len(ref) {
+		t.Fatal("Wrong length", m.Length(), len(ref))
+	}
+
+	// Check iterator
+	sort.Strings(ref)
+	for i := m.Iterator(); i.Next(); ref = ref[1:] {
+		if r := ref[0]; r != i.Key || len(r) != *i.Value {
+			t.Fatal("Wrong key or value", r, i.Key, *i.Value)
+		}
+	}
+	if l := len(ref); l > 0 {
+		t.Fatal(l, "elements left")
+	}
+}
+
+func TestBytesSeek(t *testing.T) {
+	var m MapBytes[string]
+	var ref = []string{"a", "a\x00", "ab", "b", "ba\xff", "\xff"}
+	for _, v := range ref {
+		m.Set([]byte(v), v)
+	}
+	var it = m.Iterator()
+	for _, r := range ref {
+		it.Seek([]byte(r))
+		if !it.Next() || !bytes.Equal(it.Key, []byte(r)) || *it.Value != r {
+			t.Fatal("Wrong key or value from Next after Seek", r, it.Key, it.Value)
+		}
+	}
+	it.Seek([]byte("aa"))
+	if !it.Next() || *it.Value != "ab" {
+		t.Fatal("Wrong value from Next after Seek with non-existent key", it.Value)
+	}
+	it.Seek([]byte("aa"))
+	if !it.Prev() || *it.Value != "a\x00" {
+		t.Fatal("Wrong value from Prev after Seek with non-existent key", it.Value)
+	}
+	it.Seek([]byte(""))
+	if it.Prev() {
+		t.Fatal("Prev after Seek before first key should fail", it.Key)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	var m MapString[int]
+	var keys = []string{"", "/", "/usr", "/usr/bin", "/usr/bin/go", "/usr/lib", "/var", "/var/log"}
+	for i, k := range keys {
+		m.Set(k, i)
+	}
+	var got []string
+	for i := m.IterPrefix("/usr/"); i.Next(); {
+		got = append(got, i.Key)
+	}
+	if len(got) != 3 || got[0] != "/usr/bin" || got[1] != "/usr/bin/go" || got[2] != "/usr/lib" {
+		t.Fatal("Wrong keys from IterPrefix", got)
+	}
+	if i := m.IterPrefix("/x"); i.Next() || i.Prev() {
+		t.Fatal("IterPrefix with unknown prefix returned a key", i.Key)
+	}
+	// Removing through a prefix iterator of a map whose nodes are shared replaces the nodes of the subtree.
+	var s = m.Snapshot()
+	for i := s.IterPrefix("/usr"); i.Next(); {
+		i.Rem()
+	}
+	if s.Length() != 4 || s.CountPrefix("/usr") != 0 || m.CountPrefix("/usr") != 4 {
+		t.Fatal("Wrong keys after removal through IterPrefix", s.Length(), m.Length())
+	}
+	for prefix, count := range map[string]int{"": 8, "/": 7, "/usr": 4, "/usr/bin/": 1, "/x": 0, "/var/log/x": 0} {
+		if c := m.CountPrefix(prefix); c != count {
+			t.Fatal("Wrong CountPrefix", prefix, c, count)
+		}
+	}
+	for s, longest := range map[string]string{"": "", "x": "", "/us": "/", "/usr": "/usr", "/usr/bin/gofmt": "/usr/bin/go", "/usr/lib64": "/usr/lib", "/var/lo": "/var"} {
+		if k, v, ok := m.LongestPrefixOf(s); !ok || k != longest || keys[*v] != longest {
+			t.Fatal("Wrong LongestPrefixOf", s, k, longest)
+		}
+	}
+	m.Rem("")
+	if k, _, ok := m.LongestPrefixOf("x"); ok {
+		t.Fatal("LongestPrefixOf found non-existent prefix", k)
+	}
+	if p := m.LongestCommonPrefix(); p != "/" {
+		t.Fatal("Wrong LongestCommonPrefix", p)
+	}
+	m.Rem("/")
+	if p := m.LongestCommonPrefix(); p != "/" {
+		t.Fatal("Wrong LongestCommonPrefix", p)
+	}
+	m.Rem("/var")
+	m.Rem("/var/log")
+	if p := m.LongestCommonPrefix(); p != "/usr" {
+		t.Fatal("Wrong LongestCommonPrefix", p)
+	}
+}
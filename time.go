@@ -0,0 +1,160 @@
+package critbit
+
+import (
+	"math"
+	"time"
+)
+
+// MapTime implements an associative array of V indexed by points in time. Keys are stored with nanosecond
+// precision as returned by time.Time.UnixNano, which limits them to the years 1678 to 2262. Inserting a key
+// outside of this range panics. Other methods find no entry for such keys and treat them as earlier or later
+// than all keys, so that for example the zero time can be used as the lower bound of IterBetween. Keys refer
+// to the same entry if they represent the same instant, regardless of their location and monotonic clock
+// reading. Keys returned by the map are in UTC. The zero value is an empty map ready to use.
+type MapTime[V any] struct {
+	tree[time.Time, uintKey, timeCoder, V]
+}
+
+// NewMapTime returns a new map with keys of type time.Time and values of type V.
+func NewMapTime[V any]() *MapTime[V] {
+	var r MapTime[V]
+	return &r
+}
+
+// TimeIterator iterates over the entries of a MapTime in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type TimeIterator[V any] struct {
+	iterator[time.Time, uintKey, timeCoder, V]
+}
+
+// Iterator returns a new TimeIterator.
+func (t *MapTime[V]) Iterator() *TimeIterator[V] {
+	var i TimeIterator[V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new TimeIterator that only visits keys in the range [lo, hi).
+func (t *MapTime[V]) IterRange(lo, hi time.Time) *TimeIterator[V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// TimeCursor iterates over the entries of a MapTime like a TimeIterator, but stays valid if the map is modified.
+type TimeCursor[V any] struct {
+	iterator[time.Time, uintKey, timeCoder, V]
+}
+
+// Cursor returns a new TimeCursor.
+func (t *MapTime[V]) Cursor() *TimeCursor[V] {
+	var c TimeCursor[V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapTime[V]) Snapshot() *MapTime[V] {
+	return &MapTime[V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapTime[V]) With(key time.Time, val V) *MapTime[V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapTime[V]) Without(key time.Time) *MapTime[V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapTime is a MapTime that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapTime[V any] struct {
+	atomicMap[MapTime[V], *MapTime[V], time.Time, V]
+}
+
+// MapTimeTxn is a transaction of an AtomicMapTime.
+type MapTimeTxn[V any] struct {
+	txn[MapTime[V], *MapTime[V], time.Time, V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapTime[V]) Begin() *MapTimeTxn[V] {
+	return &MapTimeTxn[V]{a.begin()}
+}
+
+// IterBetween returns a new TimeIterator that only visits keys at or after from and before to.
+func (t *MapTime[V]) IterBetween(from, to time.Time) *TimeIterator[V] {
+	return t.IterRange(from, to)
+}
+
+// MapDuration implements an associative array of V indexed by durations. It has all methods of
+// Map[time.Duration, V], which can be used directly instead. The zero value is an empty map ready to use.
+type MapDuration[V any] struct {
+	Map[time.Duration, V]
+}
+
+// NewMapDuration returns a new map with keys of type time.Duration and values of type V.
+func NewMapDuration[V any]() *MapDuration[V] {
+	var r MapDuration[V]
+	return &r
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapDuration[V]) Snapshot() *MapDuration[V] {
+	return &MapDuration[V]{*t.Map.Snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapDuration[V]) With(key time.Duration, val V) *MapDuration[V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapDuration[V]) Without(key time.Duration) *MapDuration[V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
+
+type timeCoder struct{}
+
+func (timeCoder) encode(key time.Time) uintKey {
+	if key.Before(minTime) {
+		key = minTime
+	} else if key.After(maxTime) {
+		key = maxTime
+	}
+	return uintKey(key.UnixNano()) ^ 1<<63
+}
+
+func (c timeCoder) check(key time.Time) {
+	if c.beyond(key) != 0 {
+		panic("critbit: time key out of range: " + key.String())
+	}
+}
+
+func (timeCoder) beyond(key time.Time) int {
+	if key.Before(minTime) {
+		return -1
+	} else if key.After(maxTime) {
+		return 1
+	}
+	return 0
+}
+
+func (timeCoder) decode(key uintKey) time.Time {
+	return time.Unix(0, int64(key^1<<63)).UTC()
+}
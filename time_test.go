@@ -0,0 +1,122 @@
+package critbit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	var m MapTime[int]
+	var start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		m.Set(start.Add(time.Duration(i)*time.Hour), i)
+	}
+	// Same instant in a different location refers to the same entry
+	var berlin = time.FixedZone("Berlin", 3600)
+	if v, ok := m.Get(start.Add(2 * time.Hour).In(berlin)); !ok || v != 2 {
+		t.Fatal("Wrong value for key in different location", v, ok)
+	}
+
+	var n = 3
+	var it = m.IterBetween(start.Add(150*time.Minute), start.Add(7*time.Hour))
+	for ; it.Next(); n++ {
+		if *it.Value != n || !it.Key.Equal(start.Add(time.Duration(n)*time.Hour)) {
+			t.Fatal("Wrong key or value from IterBetween", n, it.Key, *it.Value)
+		}
+	}
+	if n != 7 {
+		t.Fatal("IterBetween stopped at wrong key", n)
+	}
+	for n--; it.Prev(); n-- {
+		if *it.Value != n {
+			t.Fatal("Wrong value from IterBetween backwards", n, *it.Value)
+		}
+	}
+	if n != 2 {
+		t.Fatal("IterBetween backwards stopped at wrong key", n)
+	}
+
+	if k, v, ok := m.Floor(start.Add(90 * time.Minute)); !ok || *v != 1 || k.Location() != time.UTC {
+		t.Fatal("Wrong result from Floor", k, v, ok)
+	}
+	if k, v, ok := m.Floor(start.Add(-time.Nanosecond)); ok {
+		t.Fatal("Floor before first key found", k, v)
+	}
+
+	// Keys outside of the supported range are earlier or later than all keys
+	var zero time.Time
+	if it = m.IterBetween(zero, start.Add(time.Hour)); !it.Next() || *it.Value != 0 || it.Next() {
+		t.Fatal("Wrong keys from IterBetween with zero lower bound")
+	}
+	if _, v, ok := m.Floor(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)); !ok || *v != 9 {
+		t.Fatal("Wrong result from Floor after supported range", v, ok)
+	}
+	if _, _, ok := m.Ceiling(zero); !ok {
+		t.Fatal("No result from Ceiling before supported range")
+	}
+	var late = time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	var b MapTime[int]
+	b.Set(minTime, 1)
+	b.Set(maxTime, 2)
+	if _, ok := b.Get(zero); ok {
+		t.Fatal("Found value for time before supported range")
+	}
+	if _, ok := b.Get(late); ok {
+		t.Fatal("Found value for time after supported range")
+	}
+	if b.Rem(zero); b.Length() != 2 {
+		t.Fatal("Removed key at start of supported range")
+	}
+	if _, ok := b.Take(late); ok || b.Length() != 2 {
+		t.Fatal("Took key at end of supported range")
+	}
+	if _, v, ok := b.Higher(zero); !ok || *v != 1 {
+		t.Fatal("Higher skipped key at start of supported range", v, ok)
+	}
+	if _, v, ok := b.Lower(late); !ok || *v != 2 {
+		t.Fatal("Lower skipped key at end of supported range", v, ok)
+	}
+	if _, _, ok := b.Floor(zero); ok {
+		t.Fatal("Floor found key after time before supported range")
+	}
+	if _, _, ok := b.Ceiling(late); ok {
+		t.Fatal("Ceiling found key before time after supported range")
+	}
+	if b.Rank(zero) != 0 || b.Rank(late) != 2 || b.CountRange(zero, late) != 2 {
+		t.Fatal("Wrong rank", b.Rank(zero), b.Rank(late), b.CountRange(zero, late))
+	}
+	if it := b.IterBetween(zero, late); !it.Next() || *it.Value != 1 || !it.Next() || *it.Value != 2 || it.Next() {
+		t.Fatal("IterBetween did not visit keys at the ends of supported range")
+	}
+	if it := b.IterBetween(late, late.Add(time.Hour)); it.Next() || it.Prev() {
+		t.Fatal("IterBetween after supported range is not empty")
+	}
+	var it2 = b.Iterator()
+	if it2.Seek(zero); it2.Prev() || !it2.Next() || *it2.Value != 1 {
+		t.Fatal("Wrong position after Seek before supported range")
+	}
+	if it2.Seek(late); it2.Next() || !it2.Prev() || *it2.Value != 2 {
+		t.Fatal("Wrong position after Seek after supported range")
+	}
+	if it2.Jump(zero) {
+		t.Fatal("Jump to time before supported range succeeded")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("Inserting zero time did not panic")
+			}
+		}()
+		m.Set(zero, 0)
+	}()
+
+	var d = NewMapDuration[int]()
+	d.Set(time.Second, 1)
+	d.Set(-time.Second, -1)
+	if k, _, ok := d.Min(); !ok || k != -time.Second {
+		t.Fatal("Wrong minimum duration", k, ok)
+	}
+	if s := d.Without(time.Second); s.Length() != 1 || d.Length() != 2 {
+		t.Fatal("Wrong lengths after Without", s.Length(), d.Length())
+	}
+}
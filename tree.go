@@ -0,0 +1,678 @@
+package critbit
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// bitKey is implemented by the internal representations of keys. Bits are numbered from the
+// first (most significant) bit, starting at 0. Keys are ordered like the bit strings they represent.
+type bitKey[E any] interface {
+	// crit returns the position of the first bit that differs between the receiver and the argument.
+	// If there are no differences, ^uint(0) is returned.
+	crit(E) uint
+	// bit returns the bit at the specified position (0 or 1).
+	bit(uint) int
+}
+
+// Return true if key a is ordered before key b.
+func less[E bitKey[E]](a, b E) bool {
+	var crit = a.crit(b)
+	return crit != ^uint(0) && a.bit(crit) == 0
+}
+
+// lengthKey is implemented by internal representations of variable length keys, which consist of elements
+// that are each preceded by a presence bit. The presence bit is 1 for all elements of the key and 0 after its end.
+type lengthKey[E any] interface {
+	bitKey[E]
+	// end returns the position of the first presence bit after the end of the key.
+	end() uint
+	// presence returns true if the bit at the specified position is a presence bit.
+	presence(uint) bool
+}
+
+// coder converts between user-facing keys of type K and their internal representation E.
+// The conversion must preserve the order of the keys.
+type coder[K any, E any] interface {
+	encode(K) E
+	decode(E) K
+}
+
+// checkedCoder is implemented by coders that can only represent keys in a limited range. Their encode method
+// replaces other keys by the nearest one in the range. Queries use beyond to tell such keys apart from the keys
+// they are replaced by, and check panics if a key can't be inserted.
+type checkedCoder[K any] interface {
+	check(K)
+	// beyond returns -1 or 1 if the key is below or above the range of keys that can be represented, otherwise 0.
+	beyond(K) int
+}
+
+// tree implements the crit-bit tree shared by all map types. K is the key type used by the public API,
+// E is the internal representation of the keys and C converts between the two.
+type tree[K any, E bitKey[E], C coder[K, E], V any] struct {
+	length int
+	mods   uint // Number of insertions and removals of keys, used to detect invalid iterators
+	copies uint // Number of branches replaced by copies, used to reposition iterators
+	root   node[E, V]
+	codec  C
+	owner  uint64 // Owner tag of the branches that can be modified in place (0 if no nodes were ever shared)
+}
+
+// owners generates the owner tags of trees that share nodes.
+var owners atomic.Uint64
+
+// frozen is the owner tag of trees that may be read by several goroutines and must not be modified (see freeze).
+const frozen = ^uint64(0)
+
+type node[E bitKey[E], V any] struct {
+	key    E              // Key prefix up to critical bit
+	crit   uint           // Position of critical bit (MSB=0; ^uint(0) indicates leaf)
+	child  unsafe.Pointer // Pointer to children or value (branch[E, V] or V)
+	leaves int            // Number of leaves below the node (unused by leaves)
+}
+
+// branch holds the children of a node and the owner tag of the tree that created them.
+type branch[E bitKey[E], V any] struct {
+	nodes [2]node[E, V] // Must be the first field, so that a pointer to the branch points to the nodes as well
+	owner uint64
+}
+
+// Return walking direction
+func (c *node[E, V]) dir(key E) int {
+	return key.bit(c.crit)
+}
+
+// Return number of highest (first) bit that is different between child prefix and provided prefix.
+// If there are no differences within the prefix, the returned value is c.crit.
+func (c *node[E, V]) findCrit(key E) uint {
+	return min(key.crit(c.key), c.crit)
+}
+
+// Return number of leaves below (and including) the node.
+func (c *node[E, V]) size() int {
+	if c.crit == ^uint(0) {
+		return 1
+	}
+	return c.leaves
+}
+
+// Add delta to the leaf counts of the nodes on the path to the specified key, from the receiver up to
+// (and excluding) end.
+func (c *node[E, V]) addLeaves(key E, end *node[E, V], delta int) {
+	for ; c != end; c = &c.children()[c.dir(key)] {
+		c.leaves += delta
+	}
+}
+
+func (c *node[E, V]) children() *[2]node[E, V] {
+	return (*[2]node[E, V])(c.child)
+}
+
+func (c *node[E, V]) value() *V {
+	return (*V)(c.child)
+}
+
+// If a leaf with the same key is found, ^uint(0) and leaf node are returned.
+// Otherwise, the critical bit and the first child with differing prefix are returned.
+// As third value, the parent of the child is returned. If the child is the receiver of the method
+// parent is nil.
+func (c *node[E, V]) find(key E) (crit uint, child, parent *node[E, V]) {
+	child = c
+	crit = child.findCrit(key)
+	// Keep going deeper until a leaf or an incompatible range is found.
+	for child.crit != ^uint(0) && child.crit == crit {
+		parent = child
+		child = &(child.children())[child.dir(key)]
+		crit = child.findCrit(key)
+	}
+	return
+}
+
+// Give the tree a new owner tag before its nodes are shared with another tree. Branches are only modified in
+// place by the tree with their owner tag, so all branches the tree references now are copied before they are
+// modified. The copies get the new tag, so each path is only copied by the first modification after sharing.
+// Values of leaves are copied together with their branch, so values of leaves in owned branches and the value
+// of a leaf at the root are private to the tree.
+func (t *tree[K, E, C, V]) share() {
+	t.owner = owners.Add(1)
+	if t.length > 0 && t.root.crit == ^uint(0) {
+		var val = *t.root.value()
+		t.root.child = unsafe.Pointer(&val)
+	}
+}
+
+// Mark the tree as read-only, so that snapshots of it can be taken concurrently. Snapshots of a frozen tree don't
+// modify it, because it doesn't modify its nodes in place either.
+func (t *tree[K, E, C, V]) freeze() {
+	t.owner = frozen
+}
+
+// Panic if the tree is frozen.
+func (t *tree[K, E, C, V]) checkFrozen() {
+	if t.owner == frozen {
+		panic("critbit: modification of a map loaded from an atomic map")
+	}
+}
+
+// Return a copy of the tree that shares all nodes with the tree. Afterwards, the first modification of a path in
+// either tree copies the nodes on the path (see share). Value pointers returned by either tree afterwards refer to
+// private values (see ownValue), but older value pointers may refer to values shared with the copy and must not be
+// used for modifications.
+func (t *tree[K, E, C, V]) snapshot() tree[K, E, C, V] {
+	var s = *t
+	s.share()
+	if t.owner != frozen {
+		t.share()
+	}
+	return s
+}
+
+// Return the children of the node for modification. If the branch belongs to another tree, it is replaced by a copy
+// (including the values of leaves) owned by the tree first.
+func (t *tree[K, E, C, V]) ownChildren(n *node[E, V]) *[2]node[E, V] {
+	var b = (*branch[E, V])(n.child)
+	if b.owner != t.owner {
+		b = &branch[E, V]{nodes: b.nodes, owner: t.owner}
+		for i := range b.nodes {
+			if c := &b.nodes[i]; c.crit == ^uint(0) {
+				var val = *c.value()
+				c.child = unsafe.Pointer(&val)
+			}
+		}
+		n.child = unsafe.Pointer(b)
+		t.copies++
+	}
+	return &b.nodes
+}
+
+// Make sure that the nodes on the path to the specified key belong to the tree, so that they can be modified.
+// The tree must not be empty. It panics if the tree is frozen, because other goroutines may be reading it.
+func (t *tree[K, E, C, V]) own(k E) {
+	if t.owner == 0 {
+		return
+	}
+	t.checkFrozen()
+	for n := &t.root; n.crit != ^uint(0); {
+		n = &t.ownChildren(n)[n.dir(k)]
+	}
+}
+
+// Return the value pointer of a leaf for callers that may modify the value. If the leaf is shared with another tree,
+// the nodes on its path are copied first (see own), so the pointer refers to the copy. Frozen trees are not modified.
+func (t *tree[K, E, C, V]) ownValue(leaf *node[E, V]) *V {
+	if t.owner == 0 || t.owner == frozen {
+		return leaf.value()
+	}
+	t.own(leaf.key)
+	var _, l, _ = t.root.find(leaf.key)
+	return l.value()
+}
+
+// Rem removes the value associated with the specified key from the map.
+func (t *tree[K, E, C, V]) Rem(key K) {
+	if t.length > 0 && t.beyond(key) == 0 {
+		t.rem(t.codec.encode(key))
+	}
+}
+
+// Remove the leaf with the specified key if it exists and return its value pointer (or nil).
+// The tree must not be empty.
+func (t *tree[K, E, C, V]) rem(k E) *V {
+	t.own(k)
+	var crit, leaf, parent = t.root.find(k)
+	if crit != ^uint(0) {
+		return nil
+	}
+	var val = leaf.value()
+	t.remove(k, parent)
+	return val
+}
+
+// Remove the leaf with the specified key, given its parent as returned by find.
+func (t *tree[K, E, C, V]) remove(k E, parent *node[E, V]) {
+	if parent != nil {
+		t.root.addLeaves(k, parent, -1)
+		*parent = parent.children()[1-parent.dir(k)]
+	}
+	t.length--
+	t.mods++
+}
+
+// Take removes the value associated with the specified key from the map and returns it and true if the key existed.
+// Otherwise the zero value and false are returned.
+func (t *tree[K, E, C, V]) Take(key K) (V, bool) {
+	if t.length > 0 && t.beyond(key) == 0 {
+		if val := t.rem(t.codec.encode(key)); val != nil {
+			return *val, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// PopMin removes the entry with the lowest key from the map and returns its key and value.
+// The last return value is false if the map is empty.
+func (t *tree[K, E, C, V]) PopMin() (K, V, bool) {
+	return t.pop(0)
+}
+
+// PopMax removes the entry with the highest key from the map and returns its key and value.
+// The last return value is false if the map is empty.
+func (t *tree[K, E, C, V]) PopMax() (K, V, bool) {
+	return t.pop(1)
+}
+
+// Remove the leaf with the lowest (side 0) or highest (side 1) key and return its entry.
+func (t *tree[K, E, C, V]) pop(side int) (K, V, bool) {
+	if t.length == 0 {
+		var key K
+		var val V
+		return key, val, false
+	}
+	t.own(t.root.extreme(side).key)
+	// Walk down on one side and remove the leaf by replacing its parent with the sibling.
+	var leaf, parent = t.root, &t.root
+	for parent.crit != ^uint(0) {
+		parent.leaves--
+		var children = parent.children()
+		if children[side].crit == ^uint(0) {
+			leaf = children[side]
+			*parent = children[1-side]
+			break
+		}
+		parent = &children[side]
+	}
+	t.length--
+	t.mods++
+	return t.codec.decode(leaf.key), *leaf.value(), true
+}
+
+// RemIf removes all entries for which pred returns true and returns the number of removed entries.
+// The predicate is called once for each entry in ascending order of the keys and must not modify the map.
+func (t *tree[K, E, C, V]) RemIf(pred func(K, V) bool) int {
+	if t.length == 0 {
+		return 0
+	}
+	var length = t.remIf(&t.root, pred)
+	var removed = t.length - length
+	if removed > 0 {
+		t.length = length
+		t.mods++
+	}
+	return removed
+}
+
+// Remove all leaves below the node for which pred returns true and return the number of remaining leaves.
+// If no leaves remain, the node must be removed by the caller.
+func (t *tree[K, E, C, V]) remIf(n *node[E, V], pred func(K, V) bool) int {
+	if n.crit == ^uint(0) {
+		if pred(t.codec.decode(n.key), *n.value()) {
+			return 0
+		}
+		return 1
+	}
+	var children = t.ownChildren(n)
+	var left, right = t.remIf(&children[0], pred), t.remIf(&children[1], pred)
+	switch {
+	case left == 0:
+		*n = children[1]
+	case right == 0:
+		*n = children[0]
+	default:
+		n.leaves = left + right
+	}
+	return left + right
+}
+
+// SetP inserts or replaces the value associated with the specified key.
+// The specified value pointer can be used to modify the value without using Set.
+func (t *tree[K, E, C, V]) SetP(key K, val *V) {
+	if val == nil {
+		t.Rem(key)
+		return
+	}
+	var k = t.encodeNew(key)
+	var crit, n = uint(0), (*node[E, V])(nil)
+	if t.length > 0 {
+		// Find node with longest shared prefix and critical bit
+		t.own(k)
+		crit, n, _ = t.root.find(k)
+		// Replace value if the node is a leaf with the same key
+		if crit == ^uint(0) {
+			n.child = unsafe.Pointer(val)
+			return
+		}
+	}
+	t.insert(k, crit, n, val)
+}
+
+// Insert a new leaf, given the critical bit and node found by find (unused if the tree is empty).
+func (t *tree[K, E, C, V]) insert(k E, crit uint, n *node[E, V], val *V) {
+	// Make leaf node if tree is empty
+	if t.length == 0 {
+		t.checkFrozen()
+		t.length++
+		t.mods++
+		t.root.key = k
+		t.root.crit = ^uint(0)
+		t.root.child = unsafe.Pointer(val)
+		return
+	}
+	// Make new child nodes for found node and new value
+	t.root.addLeaves(k, n, 1)
+	var b = &branch[E, V]{nodes: [2]node[E, V]{*n, *n}, owner: t.owner}
+	var children = &b.nodes
+	// Overwrite found node
+	n.child = unsafe.Pointer(b)
+	n.crit = crit
+	n.leaves = children[0].size() + 1
+	// Set one child to value
+	var dir = n.dir(k)
+	children[dir].key = k
+	children[dir].crit = ^uint(0)
+	children[dir].child = unsafe.Pointer(val)
+	t.length++
+	t.mods++
+}
+
+// Encode a key that may be inserted into the tree.
+func (t *tree[K, E, C, V]) encodeNew(key K) E {
+	if c, ok := any(&t.codec).(checkedCoder[K]); ok {
+		c.check(key)
+	}
+	return t.codec.encode(key)
+}
+
+// Return -1 or 1 if the key is below or above the keys the coder can represent, otherwise 0 (see checkedCoder).
+func (t *tree[K, E, C, V]) beyond(key K) int {
+	if c, ok := any(&t.codec).(checkedCoder[K]); ok {
+		return c.beyond(key)
+	}
+	return 0
+}
+
+// Set inserts or replaces the value associated with the specified key.
+func (t *tree[K, E, C, V]) Set(key K, val V) {
+	t.SetP(key, &val)
+}
+
+// GetP returns the internal pointer to the value associated with the specified key.
+// If the there is no such key it returns nil. The pointer can be used to modify the value without using Set.
+func (t *tree[K, E, C, V]) GetP(key K) *V {
+	if t.length > 0 && t.beyond(key) == 0 {
+		var k = t.codec.encode(key)
+		t.own(k)
+		// Find leaf node
+		var crit, l, _ = t.root.find(k)
+		if crit == ^uint(0) {
+			return l.value()
+		}
+	}
+	return nil
+}
+
+// GetZeroP is the same as GetP, but if no value is associated with the key, a zero value is inserted and returned.
+func (t *tree[K, E, C, V]) GetZeroP(key K) *V {
+	return t.GetOrInsertP(key, nil)
+}
+
+// GetOrInsertP is the same as GetP, but if no value is associated with the key, the value returned by f
+// (or the zero value if f is nil) is inserted and its internal pointer is returned.
+// The map is only searched once. f must not modify the map.
+func (t *tree[K, E, C, V]) GetOrInsertP(key K, f func() V) *V {
+	var k = t.encodeNew(key)
+	var crit, n = uint(0), (*node[E, V])(nil)
+	if t.length > 0 {
+		t.own(k)
+		crit, n, _ = t.root.find(k)
+		if crit == ^uint(0) {
+			return n.value()
+		}
+	}
+	var val V
+	if f != nil {
+		val = f()
+	}
+	t.insert(k, crit, n, &val)
+	return &val
+}
+
+// Update calls f with the value associated with the specified key and true, or the zero value and false
+// if the key does not exist. If f returns true, the value returned by f is associated with the key.
+// Otherwise the key is removed from the map. The map is only searched once. f must not modify the map.
+func (t *tree[K, E, C, V]) Update(key K, f func(old V, exists bool) (new V, keep bool)) {
+	var k = t.encodeNew(key)
+	var crit, n, parent = uint(0), (*node[E, V])(nil), (*node[E, V])(nil)
+	if t.length > 0 {
+		t.own(k)
+		crit, n, parent = t.root.find(k)
+		if crit == ^uint(0) {
+			var val = n.value()
+			if v, keep := f(*val, true); !keep {
+				t.remove(k, parent)
+			} else {
+				*val = v
+			}
+			return
+		}
+	}
+	var zero V
+	if v, keep := f(zero, false); keep {
+		t.insert(k, crit, n, &v)
+	}
+}
+
+// Get returns the value associated with the specified key and true if the key exists.
+// Otherwise the zero value and false are returned. If a nil pointer was associated with the key,
+// Get will panic (use GetP instead).
+func (t *tree[K, E, C, V]) Get(key K) (V, bool) {
+	if t.length > 0 && t.beyond(key) == 0 {
+		var crit, l, _ = t.root.find(t.codec.encode(key))
+		if crit == ^uint(0) {
+			return *l.value(), true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Length returns the number of distinct keys in the map.
+func (t *tree[K, E, C, V]) Length() int {
+	return t.length
+}
+
+// Return the leaf with the lowest (side 0) or highest (side 1) key below the node.
+func (c *node[E, V]) extreme(side int) *node[E, V] {
+	for c.crit != ^uint(0) {
+		c = &c.children()[side]
+	}
+	return c
+}
+
+// Return the leaf with the closest key like near, but also for keys the coder can't represent (see checkedCoder).
+func (t *tree[K, E, C, V]) nearKey(key K, dir int, inclusive bool) *node[E, V] {
+	switch t.beyond(key) {
+	case 0:
+		return t.near(t.codec.encode(key), dir, inclusive)
+	case 2*dir - 1:
+		// All keys are on the other side.
+		return nil
+	}
+	if t.length == 0 {
+		return nil
+	}
+	return t.root.extreme(1 - dir)
+}
+
+// Return the leaf with the closest key after (dir 1) or before (dir 0) the specified key.
+// If inclusive is true, a leaf with the key itself is returned if it exists. Returns nil if there is no such leaf.
+func (t *tree[K, E, C, V]) near(key E, dir int, inclusive bool) *node[E, V] {
+	if t.length == 0 {
+		return nil
+	}
+	// Walk down like find and remember the last sibling in walking direction that was not taken.
+	var n, sibling = &t.root, (*node[E, V])(nil)
+	for n.crit != ^uint(0) && n.findCrit(key) == n.crit {
+		var d = n.dir(key)
+		if d != dir {
+			sibling = &n.children()[dir]
+		}
+		n = &n.children()[d]
+	}
+	var crit = key.crit(n.key)
+	if crit == ^uint(0) && inclusive {
+		return n
+	}
+	// All keys below n are on the correct side of the key if it differs in walking direction.
+	if crit != ^uint(0) && key.bit(crit) != dir {
+		return n.extreme(1 - dir)
+	}
+	if sibling == nil {
+		return nil
+	}
+	return sibling.extreme(1 - dir)
+}
+
+// Rank returns the number of keys lower than the specified key.
+func (t *tree[K, E, C, V]) Rank(key K) int {
+	if t.length == 0 || t.beyond(key) < 0 {
+		return 0
+	} else if t.beyond(key) > 0 {
+		return t.length
+	}
+	var k = t.codec.encode(key)
+	// Walk down like find and count the leaves left of the path.
+	var rank, n = 0, &t.root
+	for n.crit != ^uint(0) && n.findCrit(k) == n.crit {
+		var dir = n.dir(k)
+		if dir == 1 {
+			rank += n.children()[0].size()
+		}
+		n = &n.children()[dir]
+	}
+	// All keys below n are lower if the key differs with a 1 bit.
+	if crit := k.crit(n.key); crit != ^uint(0) && k.bit(crit) == 1 {
+		rank += n.size()
+	}
+	return rank
+}
+
+// Select returns the key with the specified rank (the i-th lowest key, starting at 0) and the internal pointer
+// to its value. The last return value is false if i is negative or not lower than the length of the map.
+func (t *tree[K, E, C, V]) Select(i int) (K, *V, bool) {
+	if i < 0 || i >= t.length {
+		return t.entry(nil)
+	}
+	var n = &t.root
+	for n.crit != ^uint(0) {
+		var left = &n.children()[0]
+		if i < left.size() {
+			n = left
+		} else {
+			i -= left.size()
+			n = &n.children()[1]
+		}
+	}
+	return t.entry(n)
+}
+
+// CountRange returns the number of keys in the range [lo, hi).
+func (t *tree[K, E, C, V]) CountRange(lo, hi K) int {
+	return max(0, t.Rank(hi)-t.Rank(lo))
+}
+
+// Return the key and value pointer of a leaf, or false if the leaf is nil.
+func (t *tree[K, E, C, V]) entry(n *node[E, V]) (K, *V, bool) {
+	if n == nil {
+		var zero K
+		return zero, nil, false
+	}
+	return t.codec.decode(n.key), t.ownValue(n), true
+}
+
+// Min returns the lowest key and the internal pointer to its value.
+// The last return value is false if the map is empty.
+func (t *tree[K, E, C, V]) Min() (K, *V, bool) {
+	if t.length == 0 {
+		return t.entry(nil)
+	}
+	return t.entry(t.root.extreme(0))
+}
+
+// Max returns the highest key and the internal pointer to its value.
+// The last return value is false if the map is empty.
+func (t *tree[K, E, C, V]) Max() (K, *V, bool) {
+	if t.length == 0 {
+		return t.entry(nil)
+	}
+	return t.entry(t.root.extreme(1))
+}
+
+// Floor returns the highest key lower than or equal to the specified key and the internal pointer to its value.
+// The last return value is false if there is no such key.
+func (t *tree[K, E, C, V]) Floor(key K) (K, *V, bool) {
+	return t.entry(t.nearKey(key, 0, true))
+}
+
+// Ceiling returns the lowest key higher than or equal to the specified key and the internal pointer to its value.
+// The last return value is false if there is no such key.
+func (t *tree[K, E, C, V]) Ceiling(key K) (K, *V, bool) {
+	return t.entry(t.nearKey(key, 1, true))
+}
+
+// Lower returns the highest key lower than the specified key and the internal pointer to its value.
+// The last return value is false if there is no such key.
+func (t *tree[K, E, C, V]) Lower(key K) (K, *V, bool) {
+	return t.entry(t.nearKey(key, 0, false))
+}
+
+// Higher returns the lowest key higher than the specified key and the internal pointer to its value.
+// The last return value is false if there is no such key.
+func (t *tree[K, E, C, V]) Higher(key K) (K, *V, bool) {
+	return t.entry(t.nearKey(key, 1, false))
+}
+
+// Return the lowest key (if first is true) or the lowest key higher than the specified key and a copy of its value.
+// Unlike Min and Higher, it never copies shared nodes, so it can be called by concurrent readers.
+func (t *tree[K, E, C, V]) peekHigher(key K, first bool) (K, V, bool) {
+	var n *node[E, V]
+	if first {
+		if t.length > 0 {
+			n = t.root.extreme(0)
+		}
+	} else {
+		n = t.near(t.codec.encode(key), 1, false)
+	}
+	if n == nil {
+		var k K
+		var v V
+		return k, v, false
+	}
+	return t.codec.decode(n.key), *n.value(), true
+}
+
+// longestPrefixOf returns the leaf with the longest key that is a prefix of s or nil if there is none.
+func longestPrefixOf[K any, E lengthKey[E], C coder[K, E], V any](t *tree[K, E, C, V], s E) *node[E, V] {
+	if t.length == 0 {
+		return nil
+	}
+	// Find the leaf sharing the longest prefix with s. Done if its key is a prefix of s.
+	var leaf = &t.root
+	for leaf.crit != ^uint(0) {
+		leaf = &leaf.children()[leaf.dir(s)]
+	}
+	var crit = s.crit(leaf.key)
+	if crit == ^uint(0) || crit == leaf.key.end() {
+		return leaf
+	}
+	// Shorter keys that are prefixes of s can only be found in the nodes above the leaf that split
+	// at a presence bit. The left child of such a node is the single key ending before the critical bit.
+	// It is a prefix of s if s shares all bits up to the critical bit with the leaf.
+	var match *node[E, V]
+	for n := &t.root; n.crit < crit; n = &n.children()[n.dir(s)] {
+		if s.presence(n.crit) {
+			match = &n.children()[0]
+		}
+	}
+	return match
+}
@@ -0,0 +1,364 @@
+package critbit
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// Tuple2 is a composite key of two integers. Tuples are ordered lexicographically by their fields.
+type Tuple2[T1, T2 Integer] struct {
+	V1 T1
+	V2 T2
+}
+
+// Tuple3 is a composite key of three integers. Tuples are ordered lexicographically by their fields.
+type Tuple3[T1, T2, T3 Integer] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+}
+
+// Tuple4 is a composite key of four integers. Tuples are ordered lexicographically by their fields.
+type Tuple4[T1, T2, T3, T4 Integer] struct {
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+}
+
+// MapTuple2 implements an associative array of V indexed by Tuple2[T1, T2].
+// The zero value is an empty map ready to use.
+type MapTuple2[T1, T2 Integer, V any] struct {
+	tree[Tuple2[T1, T2], wideKey, tuple2Coder[T1, T2], V]
+}
+
+// NewMapTuple2 returns a new map with keys of type Tuple2[T1, T2] and values of type V.
+func NewMapTuple2[T1, T2 Integer, V any]() *MapTuple2[T1, T2, V] {
+	var r MapTuple2[T1, T2, V]
+	return &r
+}
+
+// Tuple2Iterator iterates over the entries of a MapTuple2 in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type Tuple2Iterator[T1, T2 Integer, V any] struct {
+	iterator[Tuple2[T1, T2], wideKey, tuple2Coder[T1, T2], V]
+}
+
+// Iterator returns a new Tuple2Iterator.
+func (t *MapTuple2[T1, T2, V]) Iterator() *Tuple2Iterator[T1, T2, V] {
+	var i Tuple2Iterator[T1, T2, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new Tuple2Iterator that only visits keys in the range [lo, hi).
+func (t *MapTuple2[T1, T2, V]) IterRange(lo, hi Tuple2[T1, T2]) *Tuple2Iterator[T1, T2, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// Tuple2Cursor iterates over the entries of a MapTuple2 like a Tuple2Iterator, but stays valid if the map is modified.
+type Tuple2Cursor[T1, T2 Integer, V any] struct {
+	iterator[Tuple2[T1, T2], wideKey, tuple2Coder[T1, T2], V]
+}
+
+// Cursor returns a new Tuple2Cursor.
+func (t *MapTuple2[T1, T2, V]) Cursor() *Tuple2Cursor[T1, T2, V] {
+	var c Tuple2Cursor[T1, T2, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapTuple2[T1, T2, V]) Snapshot() *MapTuple2[T1, T2, V] {
+	return &MapTuple2[T1, T2, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapTuple2[T1, T2, V]) With(key Tuple2[T1, T2], val V) *MapTuple2[T1, T2, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapTuple2[T1, T2, V]) Without(key Tuple2[T1, T2]) *MapTuple2[T1, T2, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapTuple2 is a MapTuple2 that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapTuple2[T1, T2 Integer, V any] struct {
+	atomicMap[MapTuple2[T1, T2, V], *MapTuple2[T1, T2, V], Tuple2[T1, T2], V]
+}
+
+// MapTuple2Txn is a transaction of an AtomicMapTuple2.
+type MapTuple2Txn[T1, T2 Integer, V any] struct {
+	txn[MapTuple2[T1, T2, V], *MapTuple2[T1, T2, V], Tuple2[T1, T2], V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapTuple2[T1, T2, V]) Begin() *MapTuple2Txn[T1, T2, V] {
+	return &MapTuple2Txn[T1, T2, V]{a.begin()}
+}
+
+// MapTuple3 implements an associative array of V indexed by Tuple3[T1, T2, T3].
+// The zero value is an empty map ready to use.
+type MapTuple3[T1, T2, T3 Integer, V any] struct {
+	tree[Tuple3[T1, T2, T3], wideKey, tuple3Coder[T1, T2, T3], V]
+}
+
+// NewMapTuple3 returns a new map with keys of type Tuple3[T1, T2, T3] and values of type V.
+func NewMapTuple3[T1, T2, T3 Integer, V any]() *MapTuple3[T1, T2, T3, V] {
+	var r MapTuple3[T1, T2, T3, V]
+	return &r
+}
+
+// Tuple3Iterator iterates over the entries of a MapTuple3 in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type Tuple3Iterator[T1, T2, T3 Integer, V any] struct {
+	iterator[Tuple3[T1, T2, T3], wideKey, tuple3Coder[T1, T2, T3], V]
+}
+
+// Iterator returns a new Tuple3Iterator.
+func (t *MapTuple3[T1, T2, T3, V]) Iterator() *Tuple3Iterator[T1, T2, T3, V] {
+	var i Tuple3Iterator[T1, T2, T3, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new Tuple3Iterator that only visits keys in the range [lo, hi).
+func (t *MapTuple3[T1, T2, T3, V]) IterRange(lo, hi Tuple3[T1, T2, T3]) *Tuple3Iterator[T1, T2, T3, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// Tuple3Cursor iterates over the entries of a MapTuple3 like a Tuple3Iterator, but stays valid if the map is modified.
+type Tuple3Cursor[T1, T2, T3 Integer, V any] struct {
+	iterator[Tuple3[T1, T2, T3], wideKey, tuple3Coder[T1, T2, T3], V]
+}
+
+// Cursor returns a new Tuple3Cursor.
+func (t *MapTuple3[T1, T2, T3, V]) Cursor() *Tuple3Cursor[T1, T2, T3, V] {
+	var c Tuple3Cursor[T1, T2, T3, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapTuple3[T1, T2, T3, V]) Snapshot() *MapTuple3[T1, T2, T3, V] {
+	return &MapTuple3[T1, T2, T3, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapTuple3[T1, T2, T3, V]) With(key Tuple3[T1, T2, T3], val V) *MapTuple3[T1, T2, T3, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapTuple3[T1, T2, T3, V]) Without(key Tuple3[T1, T2, T3]) *MapTuple3[T1, T2, T3, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapTuple3 is a MapTuple3 that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapTuple3[T1, T2, T3 Integer, V any] struct {
+	atomicMap[MapTuple3[T1, T2, T3, V], *MapTuple3[T1, T2, T3, V], Tuple3[T1, T2, T3], V]
+}
+
+// MapTuple3Txn is a transaction of an AtomicMapTuple3.
+type MapTuple3Txn[T1, T2, T3 Integer, V any] struct {
+	txn[MapTuple3[T1, T2, T3, V], *MapTuple3[T1, T2, T3, V], Tuple3[T1, T2, T3], V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapTuple3[T1, T2, T3, V]) Begin() *MapTuple3Txn[T1, T2, T3, V] {
+	return &MapTuple3Txn[T1, T2, T3, V]{a.begin()}
+}
+
+// MapTuple4 implements an associative array of V indexed by Tuple4[T1, T2, T3, T4].
+// The zero value is an empty map ready to use.
+type MapTuple4[T1, T2, T3, T4 Integer, V any] struct {
+	tree[Tuple4[T1, T2, T3, T4], wideKey, tuple4Coder[T1, T2, T3, T4], V]
+}
+
+// NewMapTuple4 returns a new map with keys of type Tuple4[T1, T2, T3, T4] and values of type V.
+func NewMapTuple4[T1, T2, T3, T4 Integer, V any]() *MapTuple4[T1, T2, T3, T4, V] {
+	var r MapTuple4[T1, T2, T3, T4, V]
+	return &r
+}
+
+// Tuple4Iterator iterates over the entries of a MapTuple4 in the order of their keys. The iterator becomes invalid
+// if a key is inserted into or removed from the underlying map, until the Reset or Jump method is called.
+type Tuple4Iterator[T1, T2, T3, T4 Integer, V any] struct {
+	iterator[Tuple4[T1, T2, T3, T4], wideKey, tuple4Coder[T1, T2, T3, T4], V]
+}
+
+// Iterator returns a new Tuple4Iterator.
+func (t *MapTuple4[T1, T2, T3, T4, V]) Iterator() *Tuple4Iterator[T1, T2, T3, T4, V] {
+	var i Tuple4Iterator[T1, T2, T3, T4, V]
+	i.init(&t.tree)
+	return &i
+}
+
+// IterRange returns a new Tuple4Iterator that only visits keys in the range [lo, hi).
+func (t *MapTuple4[T1, T2, T3, T4, V]) IterRange(lo, hi Tuple4[T1, T2, T3, T4]) *Tuple4Iterator[T1, T2, T3, T4, V] {
+	var i = t.Iterator()
+	i.bound(lo, hi, true, true)
+	return i
+}
+
+// Tuple4Cursor iterates over the entries of a MapTuple4 like a Tuple4Iterator, but stays valid if the map is modified.
+type Tuple4Cursor[T1, T2, T3, T4 Integer, V any] struct {
+	iterator[Tuple4[T1, T2, T3, T4], wideKey, tuple4Coder[T1, T2, T3, T4], V]
+}
+
+// Cursor returns a new Tuple4Cursor.
+func (t *MapTuple4[T1, T2, T3, T4, V]) Cursor() *Tuple4Cursor[T1, T2, T3, T4, V] {
+	var c Tuple4Cursor[T1, T2, T3, T4, V]
+	c.init(&t.tree)
+	c.stable = true
+	return &c
+}
+
+// Snapshot returns a copy of the map in constant time that shares all nodes with the map until they are modified.
+func (t *MapTuple4[T1, T2, T3, T4, V]) Snapshot() *MapTuple4[T1, T2, T3, T4, V] {
+	return &MapTuple4[T1, T2, T3, T4, V]{t.snapshot()}
+}
+
+// With returns a snapshot of the map in which val is associated with key, without modifying the map (see Snapshot).
+func (t *MapTuple4[T1, T2, T3, T4, V]) With(key Tuple4[T1, T2, T3, T4], val V) *MapTuple4[T1, T2, T3, T4, V] {
+	var s = t.Snapshot()
+	s.Set(key, val)
+	return s
+}
+
+// Without returns a snapshot of the map without key, without modifying the map (see Snapshot).
+func (t *MapTuple4[T1, T2, T3, T4, V]) Without(key Tuple4[T1, T2, T3, T4]) *MapTuple4[T1, T2, T3, T4, V] {
+	var s = t.Snapshot()
+	s.Rem(key)
+	return s
+}
+
+// AtomicMapTuple4 is a MapTuple4 that can be read without locks while it is modified. The zero value is an empty map.
+type AtomicMapTuple4[T1, T2, T3, T4 Integer, V any] struct {
+	atomicMap[MapTuple4[T1, T2, T3, T4, V], *MapTuple4[T1, T2, T3, T4, V], Tuple4[T1, T2, T3, T4], V]
+}
+
+// MapTuple4Txn is a transaction of an AtomicMapTuple4.
+type MapTuple4Txn[T1, T2, T3, T4 Integer, V any] struct {
+	txn[MapTuple4[T1, T2, T3, T4, V], *MapTuple4[T1, T2, T3, T4, V], Tuple4[T1, T2, T3, T4], V]
+}
+
+// Begin starts a transaction. It waits until other transactions and writers have finished.
+func (a *AtomicMapTuple4[T1, T2, T3, T4, V]) Begin() *MapTuple4Txn[T1, T2, T3, T4, V] {
+	return &MapTuple4Txn[T1, T2, T3, T4, V]{a.begin()}
+}
+
+// wideKey is the internal representation of tuple keys. The fields are packed into a bit string
+// of up to 256 bits, starting at the most significant bit of the first word.
+type wideKey [4]uint64
+
+func (k wideKey) crit(o wideKey) uint {
+	for i := range k {
+		if x := k[i] ^ o[i]; x != 0 {
+			return uint(i*64 + bits.LeadingZeros64(x))
+		}
+	}
+	return ^uint(0)
+}
+
+func (k wideKey) bit(pos uint) int {
+	return int(k[pos/64]>>(63-pos%64)) & 1
+}
+
+// put stores the lowest w bits of v at the specified position.
+func (k *wideKey) put(pos uint, v uint64, w uint) {
+	var i, off = pos / 64, pos % 64
+	if off+w <= 64 {
+		k[i] |= v << (64 - off - w)
+		return
+	}
+	k[i] |= v >> (off + w - 64)
+	k[i+1] |= v << (128 - off - w)
+}
+
+// get returns w bits from the specified position.
+func (k *wideKey) get(pos uint, w uint) uint64 {
+	var i, off = pos / 64, pos % 64
+	var v = k[i] << off >> (64 - w)
+	if off+w > 64 {
+		v |= k[i+1] >> (128 - off - w)
+	}
+	return v
+}
+
+// putField packs an integer at the specified position and returns the position after it.
+// The sign bit of signed integers is flipped so that negative values are ordered before positive ones.
+func putField[T Integer](k *wideKey, pos uint, v T) uint {
+	var w = uint(unsafe.Sizeof(v)) * 8
+	var u = uint64(v)
+	if ^T(0) < 0 {
+		u ^= 1 << (w - 1)
+	}
+	if w < 64 {
+		u &= 1<<w - 1
+	}
+	k.put(pos, u, w)
+	return pos + w
+}
+
+// getField unpacks an integer stored by putField and returns the position after it.
+func getField[T Integer](k *wideKey, pos uint, v *T) uint {
+	var w = uint(unsafe.Sizeof(*v)) * 8
+	var u = k.get(pos, w)
+	if ^T(0) < 0 {
+		u ^= 1 << (w - 1)
+	}
+	*v = T(u)
+	return pos + w
+}
+
+type tuple2Coder[T1, T2 Integer] struct{}
+
+func (tuple2Coder[T1, T2]) encode(key Tuple2[T1, T2]) (k wideKey) {
+	putField(&k, putField(&k, 0, key.V1), key.V2)
+	return
+}
+
+func (tuple2Coder[T1, T2]) decode(k wideKey) (key Tuple2[T1, T2]) {
+	getField(&k, getField(&k, 0, &key.V1), &key.V2)
+	return
+}
+
+type tuple3Coder[T1, T2, T3 Integer] struct{}
+
+func (tuple3Coder[T1, T2, T3]) encode(key Tuple3[T1, T2, T3]) (k wideKey) {
+	putField(&k, putField(&k, putField(&k, 0, key.V1), key.V2), key.V3)
+	return
+}
+
+func (tuple3Coder[T1, T2, T3]) decode(k wideKey) (key Tuple3[T1, T2, T3]) {
+	getField(&k, getField(&k, getField(&k, 0, &key.V1), &key.V2), &key.V3)
+	return
+}
+
+type tuple4Coder[T1, T2, T3, T4 Integer] struct{}
+
+func (tuple4Coder[T1, T2, T3, T4]) encode(key Tuple4[T1, T2, T3, T4]) (k wideKey) {
+	putField(&k, putField(&k, putField(&k, putField(&k, 0, key.V1), key.V2), key.V3), key.V4)
+	return
+}
+
+func (tuple4Coder[T1, T2, T3, T4]) decode(k wideKey) (key Tuple4[T1, T2, T3, T4]) {
+	getField(&k, getField(&k, getField(&k, getField(&k, 0, &key.V1), &key.V2), &key.V3), &key.V4)
+	return
+}
@@ -0,0 +1,61 @@
+package critbit
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestTuple(t *testing.T) {
+	type key = Tuple3[int16, uint64, int8]
+	var ref []key
+	var m MapTuple3[int16, uint64, int8, key]
+	for i := 0; i < 1000; i++ {
+		var k = key{int16(rand.Intn(7) - 3), rand.Uint64() % 5, int8(rand.Int())}
+		if m.GetP(k) == nil {
+			ref = append(ref, k)
+		}
+		m.Set(k, k)
+	}
+	var extremes = []key{{math.MinInt16, 0, math.MinInt8}, {math.MaxInt16, math.MaxUint64, math.MaxInt8}}
+	for _, k := range extremes {
+		ref = append(ref, k)
+		m.Set(k, k)
+	}
+	sort.Slice(ref, func(i, j int) bool {
+		var a, b = ref[i], ref[j]
+		if a.V1 != b.V1 {
+			return a.V1 < b.V1
+		}
+		if a.V2 != b.V2 {
+			return a.V2 < b.V2
+		}
+		return a.V3 < b.V3
+	})
+	for i := m.Iterator(); i.Next(); ref = ref[1:] {
+		if i.Key != ref[0] || *i.Value != ref[0] {
+			t.Fatal("Wrong key or value", ref[0], i.Key, i.Value)
+		}
+	}
+	if len(ref) > 0 {
+		t.Fatal(len(ref), "elements left")
+	}
+}
+
+func TestTupleFieldsAcrossWords(t *testing.T) {
+	var m MapTuple4[uint8, int64, int64, uint64, int]
+	var keys = []Tuple4[uint8, int64, int64, uint64]{{0, -1, 1, 0}, {0, 0, -1, 1}, {0, 0, 0, 0}, {0, 0, 0, 1}, {1, math.MinInt64, math.MinInt64, 0}}
+	for i, k := range keys {
+		m.Set(k, i)
+	}
+	var n = 0
+	for i := m.Iterator(); i.Next(); n++ {
+		if i.Key != keys[n] || *i.Value != n {
+			t.Fatal("Wrong key or value", keys[n], i.Key, *i.Value)
+		}
+	}
+	if n != len(keys) {
+		t.Fatal("Wrong number of keys", n)
+	}
+}